@@ -0,0 +1,227 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ConfigDefault is an struct that contains the configuration of the api client.
+type ConfigDefault struct {
+	// BaseURL is the address of the api (e.g. http://localhost:8080).
+	BaseURL string
+	// Client is the http client the requests are sent with.
+	Client *http.Client
+	// MaxAttempts is the number of attempts of an idempotent request before giving up.
+	MaxAttempts int
+	// Backoff is the wait after the first failed attempt, doubled after each one.
+	Backoff time.Duration
+}
+
+// NewDefault returns a new instance of an api client.
+func NewDefault(c *ConfigDefault) *Default {
+	// default config
+	defaultCfg := &ConfigDefault{
+		BaseURL:     "http://localhost:8080",
+		Client:      &http.Client{Timeout: 30 * time.Second},
+		MaxAttempts: 3,
+		Backoff:     100 * time.Millisecond,
+	}
+	if c != nil {
+		if c.BaseURL != "" {
+			defaultCfg.BaseURL = c.BaseURL
+		}
+		if c.Client != nil {
+			defaultCfg.Client = c.Client
+		}
+		if c.MaxAttempts > 0 {
+			defaultCfg.MaxAttempts = c.MaxAttempts
+		}
+		if c.Backoff > 0 {
+			defaultCfg.Backoff = c.Backoff
+		}
+	}
+
+	return &Default{
+		baseURL:     strings.TrimSuffix(defaultCfg.BaseURL, "/"),
+		client:      defaultCfg.Client,
+		maxAttempts: defaultCfg.MaxAttempts,
+		backoff:     defaultCfg.Backoff,
+	}
+}
+
+// Default is an struct that represents a client of the vehicles api.
+// Errors of the api are returned as *Error, which unwrap to the Err* errors of the services they were caused by.
+type Default struct {
+	// baseURL is the address of the api, without trailing slash.
+	baseURL string
+	// client is the http client the requests are sent with.
+	client *http.Client
+	// maxAttempts is the number of attempts of an idempotent request.
+	maxAttempts int
+	// backoff is the wait after the first failed attempt.
+	backoff time.Duration
+}
+
+// envelope is an struct that represents the json body of the responses of the api.
+type envelope struct {
+	Message string            `json:"message"`
+	Data    json.RawMessage   `json:"data"`
+	Error   string            `json:"error"`
+	Code    string            `json:"code"`
+	Fields  map[string]string `json:"fields"`
+}
+
+// request is an struct that represents a request to the api.
+type request struct {
+	// method is the http method.
+	method string
+	// path is the path of the endpoint, with its params already escaped.
+	path string
+	// query are the query params, nil if there are not any.
+	query url.Values
+	// body is encoded as json, nil if there is not any.
+	body any
+	// accept is the Accept header, json if empty.
+	accept string
+}
+
+// do sends the request and decodes the envelope of its response, returning an *Error if the status is not 2xx.
+func (c *Default) do(ctx context.Context, r request) (e envelope, err error) {
+	rs, err := c.send(ctx, r)
+	if err != nil {
+		return
+	}
+	defer rs.Body.Close()
+
+	if rs.StatusCode == http.StatusNoContent {
+		return
+	}
+	if err = json.NewDecoder(rs.Body).Decode(&e); err != nil {
+		err = fmt.Errorf("client: decoding response of %s %s: %w", r.method, r.path, err)
+		return
+	}
+	return
+}
+
+// data sends the request and decodes the data of its response into v.
+func (c *Default) data(ctx context.Context, r request, v any) (err error) {
+	e, err := c.do(ctx, r)
+	if err != nil {
+		return
+	}
+
+	if err = json.Unmarshal(e.Data, v); err != nil {
+		err = fmt.Errorf("client: decoding data of %s %s: %w", r.method, r.path, err)
+		return
+	}
+	return
+}
+
+// send sends the request, retrying idempotent ones after network errors and transient statuses.
+// The body of the returned response has to be closed, it is only returned if the status is 2xx.
+func (c *Default) send(ctx context.Context, r request) (rs *http.Response, err error) {
+	var body []byte
+	if r.body != nil {
+		if body, err = json.Marshal(r.body); err != nil {
+			return
+		}
+	}
+	u := c.baseURL + r.path
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+	accept := r.accept
+	if accept == "" {
+		accept = "application/json"
+	}
+
+	attempts := 1
+	if idempotent(r.method) {
+		attempts = c.maxAttempts
+	}
+	backoff := c.backoff
+	for attempt := 1; ; attempt++ {
+		var rq *http.Request
+		rq, err = http.NewRequestWithContext(ctx, r.method, u, bytes.NewReader(body))
+		if err != nil {
+			return
+		}
+		rq.Header.Set("Accept", accept)
+		if body != nil {
+			rq.Header.Set("Content-Type", "application/json")
+		}
+
+		rs, err = c.client.Do(rq)
+		if err == nil && rs.StatusCode < 300 {
+			return
+		}
+		if err == nil {
+			err = responseError(rs)
+			rs.Body.Close()
+			rs = nil
+		}
+		if attempt >= attempts || ctx.Err() != nil || !retryable(err) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// idempotent returns whether a request with the method can be sent again without side effects.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable returns whether a request that failed with err may succeed if it is sent again.
+func retryable(err error) bool {
+	var re *Error
+	if !errors.As(err, &re) {
+		// the server could not be reached
+		return true
+	}
+	switch re.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// responseError returns the error of a response with a status that is not 2xx.
+func responseError(rs *http.Response) error {
+	e := &Error{StatusCode: rs.StatusCode}
+	b, _ := io.ReadAll(rs.Body)
+
+	var body envelope
+	if json.Unmarshal(b, &body) == nil {
+		// some endpoints report the error in the message
+		e.Message = body.Error
+		if e.Message == "" {
+			e.Message = body.Message
+		}
+		e.Code = body.Code
+		e.Fields = body.Fields
+		e.err = serviceErrors[e.Code]
+	} else {
+		e.Message = strings.TrimSpace(string(b))
+	}
+	return e
+}
@@ -0,0 +1,263 @@
+package client_test
+
+import (
+	"app/client"
+	"app/internal"
+	"app/internal/application"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newTestServer returns a server of the api over a few vehicles, whose requests go through wrap if it is not nil.
+func newTestServer(t *testing.T, wrap func(next http.Handler) http.Handler) *httptest.Server {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	vehicles := []internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{
+			Brand: "Ford", Model: "Focus", Registration: "AAA111", Year: 2015, Color: "Blue", MaxSpeed: 200,
+			FuelType: "gasoline", Transmission: "manual", Passengers: 5, Height: 150, Width: 180, Weight: 1300,
+		}},
+		{ID: 2, Attributes: internal.VehicleAttributes{
+			Brand: "Ford", Model: "Mustang", Registration: "BBB222", Year: 2020, Color: "Red", MaxSpeed: 250,
+			FuelType: "gasoline", Transmission: "automatic", Passengers: 4, Height: 140, Width: 190, Weight: 1700,
+		}},
+	}
+	s, stop, err := application.NewServices(internal.LoadData{Data: vehicles, LastId: len(vehicles)}, internal.DefaultMaintenanceIntervals())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stop)
+	rt, err := application.NewRouter(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var h http.Handler = rt
+	if wrap != nil {
+		h = wrap(rt)
+	}
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// failFirst returns a wrapper answering the first n requests with the status code, counting all of them.
+func failFirst(n int32, code int, requests *atomic.Int32) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) <= n {
+				w.WriteHeader(code)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestDefault_Vehicles(t *testing.T) {
+	srv := newTestServer(t, nil)
+	c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL})
+	ctx := context.Background()
+
+	v, err := c.Insert(ctx, client.Vehicle{Attributes: client.VehicleAttributes{
+		Brand: "Nissan", Model: "Leaf", Registration: "CCC333", Year: 2021, Color: "White", MaxSpeed: 150,
+		FuelType: "electric", Transmission: "automatic", Passengers: 5, Height: 155, Width: 177, Weight: 1500,
+		BatteryCapacity: 40, Range: 270, ChargingConnector: "CHAdeMO",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.ID != 3 || v.Attributes.Range != 270 || v.Attributes.Height != 155 {
+		t.Errorf("inserted = %+v", v)
+	}
+
+	v, err = c.UpdateMaxSpeedById(ctx, 3, 160)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Attributes.MaxSpeed != 160 {
+		t.Errorf("max speed = %d, want 160", v.Attributes.MaxSpeed)
+	}
+
+	vs, err := c.FindAllByWeight(ctx, client.ClosedInterval(1400, 1700))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vs) != 2 || vs[0].ID != 2 || vs[1].ID != 3 {
+		t.Errorf("vehicles = %+v, want 2 and 3", vs)
+	}
+}
+
+func TestDefault_Errors(t *testing.T) {
+	srv := newTestServer(t, nil)
+	c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		code int
+		want error
+	}{
+		{"vehicle not found", func() error {
+			_, err := c.UpdateMaxSpeedById(ctx, 99, 100)
+			return err
+		}, http.StatusNotFound, client.ErrVehicleNotFound},
+		{"vehicles not found", func() error {
+			_, err := c.FindAllByFuelType(ctx, "diesel")
+			return err
+		}, http.StatusNotFound, client.ErrVehiclesNotFound},
+		{"invalid vehicle", func() error {
+			_, err := c.UpdateMaxSpeedById(ctx, 1, -1)
+			return err
+		}, http.StatusBadRequest, client.ErrInvalidVehicleMaxSpeed},
+		{"invalid vehicle interval", func() error {
+			_, err := c.FindAllByWeight(ctx, client.Interval{Min: &client.Bound{Value: -1, Inclusive: true}})
+			return err
+		}, http.StatusBadRequest, client.ErrInvalidVehicleWeight},
+		{"average of a brand not found", func() error {
+			_, err := c.CalculateAverageSpeedByBrand(ctx, "Tesla")
+			return err
+		}, http.StatusNotFound, client.ErrVehiclesNotFound},
+		{"invalid search limit", func() error {
+			_, err := c.Search(ctx, "ford", -1)
+			return err
+		}, http.StatusBadRequest, client.ErrInvalidSearchLimit},
+		{"invalid similar limit", func() error {
+			_, err := c.FindAllSimilar(ctx, client.VehicleSimilarQuery{ID: 1, Limit: -1})
+			return err
+		}, http.StatusBadRequest, client.ErrInvalidSimilarLimit},
+		{"fleet not found", func() error {
+			_, err := c.FindFleetById(ctx, 99)
+			return err
+		}, http.StatusNotFound, client.ErrFleetNotFound},
+		{"owner has vehicles", func() error {
+			o, err := c.InsertOwner(ctx, client.OwnerAttributes{Name: "Jane Doe", Email: "jane@example.com"})
+			if err != nil {
+				return err
+			}
+			if _, err = c.UpdateOwnerById(ctx, 1, o.ID); err != nil {
+				return err
+			}
+			return c.DeleteOwner(ctx, o.ID)
+		}, http.StatusConflict, client.ErrOwnerHasVehicles},
+		{"invalid webhook secret", func() error {
+			_, err := c.InsertWebhook(ctx, client.WebhookAttributes{URL: "http://localhost:9999", Secret: "short", Events: []client.WebhookEventType{"vehicle.created"}})
+			return err
+		}, http.StatusBadRequest, client.ErrInvalidWebhookSecret},
+		{"graphql vehicle not found", func() error {
+			return c.GraphQL(ctx, `{ vehicle(id: 99) { id } }`, nil, nil)
+		}, http.StatusOK, client.ErrVehicleNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			var e *client.Error
+			if !errors.As(err, &e) || e.StatusCode != tt.code {
+				t.Errorf("err = %#v, want status %d", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestDefault_Retries(t *testing.T) {
+	t.Run("idempotent", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := newTestServer(t, failFirst(2, http.StatusServiceUnavailable, &requests))
+		c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL, MaxAttempts: 3, Backoff: time.Millisecond})
+
+		vs, err := c.FindAll(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(vs) != 2 || requests.Load() != 3 {
+			t.Errorf("vehicles = %d, requests = %d, want 2 and 3", len(vs), requests.Load())
+		}
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := newTestServer(t, failFirst(5, http.StatusBadGateway, &requests))
+		c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL, MaxAttempts: 3, Backoff: time.Millisecond})
+
+		_, err := c.FindAll(context.Background())
+		var e *client.Error
+		if !errors.As(err, &e) || e.StatusCode != http.StatusBadGateway {
+			t.Errorf("err = %v, want status %d", err, http.StatusBadGateway)
+		}
+		if requests.Load() != 3 {
+			t.Errorf("requests = %d, want 3", requests.Load())
+		}
+	})
+
+	t.Run("not idempotent", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := newTestServer(t, failFirst(1, http.StatusServiceUnavailable, &requests))
+		c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL, MaxAttempts: 3, Backoff: time.Millisecond})
+
+		_, err := c.InsertOwner(context.Background(), client.OwnerAttributes{Name: "Jane Doe", Email: "jane@example.com"})
+		var e *client.Error
+		if !errors.As(err, &e) || e.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("err = %v, want status %d", err, http.StatusServiceUnavailable)
+		}
+		if requests.Load() != 1 {
+			t.Errorf("requests = %d, want 1", requests.Load())
+		}
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := newTestServer(t, failFirst(1, http.StatusInternalServerError, &requests))
+		c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL, MaxAttempts: 3, Backoff: time.Millisecond})
+
+		if _, err := c.FindAll(context.Background()); err == nil {
+			t.Error("err = nil, want the error of the response")
+		}
+		if requests.Load() != 1 {
+			t.Errorf("requests = %d, want 1", requests.Load())
+		}
+	})
+}
+
+func TestDefault_ContextCancellation(t *testing.T) {
+	t.Run("during the request", func(t *testing.T) {
+		srv := newTestServer(t, func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			})
+		})
+		c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL, Backoff: time.Millisecond})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if _, err := c.FindAll(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+		}
+	})
+
+	t.Run("during the backoff", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := newTestServer(t, failFirst(5, http.StatusServiceUnavailable, &requests))
+		c := client.NewDefault(&client.ConfigDefault{BaseURL: srv.URL, MaxAttempts: 5, Backoff: time.Hour})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		start := time.Now()
+		if _, err := c.FindAll(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want %v", err, context.Canceled)
+		}
+		if d := time.Since(start); d > time.Second || requests.Load() != 1 {
+			t.Errorf("returned after %v and %d requests, want right after the first one", d, requests.Load())
+		}
+	})
+}
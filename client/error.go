@@ -0,0 +1,166 @@
+package client
+
+import (
+	"app/internal"
+	"fmt"
+	"net/http"
+)
+
+// Error is an struct that represents an error response of the api.
+type Error struct {
+	// StatusCode is the status of the response.
+	StatusCode int
+	// Message is the error message of the response.
+	Message string
+	// Code is the stable code of the error (e.g. vehicle_not_found), empty if the response has not any.
+	Code string
+	// Fields are the invalid fields of the request body, nil if there are not any.
+	Fields map[string]string
+	// err is the service error the response was caused by, nil if it is not known.
+	err error
+}
+
+// Error returns the status and message of the response.
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("client: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("client: %d %s", e.StatusCode, e.Message)
+}
+
+// Unwrap returns the service error the response was caused by.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// The errors of the services of the api, *Error unwraps to them (e.g. errors.Is(err, client.ErrVehicleNotFound)).
+var (
+	ErrVehiclesNotFound                = internal.ErrServiceVehiclesNotFound
+	ErrInvalidVehicleBrand             = internal.ErrServiceInvalidVehicleBrand
+	ErrInvalidVehicleModel             = internal.ErrServiceInvalidVehicleModel
+	ErrInvalidVehicleRegistration      = internal.ErrServiceInvalidVehicleRegistration
+	ErrInvalidVehicleYear              = internal.ErrServiceInvalidVehicleYear
+	ErrInvalidVehicleColor             = internal.ErrServiceInvalidVehicleColor
+	ErrInvalidVehicleMaxSpeed          = internal.ErrServiceInvalidVehicleMaxSpeed
+	ErrInvalidVehicleFuelType          = internal.ErrServiceInvalidVehicleFuelType
+	ErrInvalidVehicleTransmission      = internal.ErrServiceInvalidVehicleTransmission
+	ErrInvalidVehiclePassengers        = internal.ErrServiceInvalidVehiclePassengers
+	ErrInvalidVehicleHeight            = internal.ErrServiceInvalidVehicleHeight
+	ErrInvalidVehicleWidth             = internal.ErrServiceInvalidVehicleWidth
+	ErrInvalidVehicleWeight            = internal.ErrServiceInvalidVehicleWeight
+	ErrInvalidVehicleBatteryCapacity   = internal.ErrServiceInvalidVehicleBatteryCapacity
+	ErrInvalidVehicleRange             = internal.ErrServiceInvalidVehicleRange
+	ErrInvalidVehicleChargingConnector = internal.ErrServiceInvalidVehicleChargingConnector
+	ErrInvalidVehicleOwner             = internal.ErrServiceInvalidVehicleOwner
+	ErrVehicleIdAlreadyExists          = internal.ErrServiceVehicleIdAlreadyExists
+	ErrVehicleNotFound                 = internal.ErrServiceVehicleNotFound
+	ErrInvalidStatsGroupBy             = internal.ErrServiceInvalidStatsGroupBy
+	ErrInvalidStatsMetric              = internal.ErrServiceInvalidStatsMetric
+	ErrInvalidHistogramAttribute       = internal.ErrServiceInvalidHistogramAttribute
+	ErrInvalidHistogramBuckets         = internal.ErrServiceInvalidHistogramBuckets
+	ErrInvalidHistogramFilter          = internal.ErrServiceInvalidHistogramFilter
+	ErrInvalidHistogramGroupBy         = internal.ErrServiceInvalidHistogramGroupBy
+	ErrInvalidSearchQuery              = internal.ErrServiceInvalidSearchQuery
+	ErrInvalidSearchLimit              = internal.ErrServiceInvalidSearchLimit
+	ErrInvalidSimilarWeights           = internal.ErrServiceInvalidSimilarWeights
+	ErrInvalidSimilarLimit             = internal.ErrServiceInvalidSimilarLimit
+
+	ErrOwnerNotFound     = internal.ErrServiceOwnerNotFound
+	ErrOwnerHasVehicles  = internal.ErrServiceOwnerHasVehicles
+	ErrInvalidOwnerName  = internal.ErrServiceInvalidOwnerName
+	ErrInvalidOwnerEmail = internal.ErrServiceInvalidOwnerEmail
+	ErrInvalidOwnerPhone = internal.ErrServiceInvalidOwnerPhone
+
+	ErrFleetNotFound          = internal.ErrServiceFleetNotFound
+	ErrFleetNameAlreadyExists = internal.ErrServiceFleetNameAlreadyExists
+	ErrFleetVehicleNotFound   = internal.ErrServiceFleetVehicleNotFound
+	ErrInvalidFleetName       = internal.ErrServiceInvalidFleetName
+	ErrInvalidFleetVehicles   = internal.ErrServiceInvalidFleetVehicles
+
+	ErrMaintenanceNotFound         = internal.ErrServiceMaintenanceNotFound
+	ErrInvalidMaintenanceDate      = internal.ErrServiceInvalidMaintenanceDate
+	ErrInvalidMaintenanceOdometer  = internal.ErrServiceInvalidMaintenanceOdometer
+	ErrInvalidMaintenanceType      = internal.ErrServiceInvalidMaintenanceType
+	ErrInvalidMaintenanceCost      = internal.ErrServiceInvalidMaintenanceCost
+	ErrInvalidMaintenanceIntervals = internal.ErrServiceInvalidMaintenanceIntervals
+	ErrInvalidMaintenanceDueDays   = internal.ErrServiceInvalidMaintenanceDueDays
+
+	ErrReferenceKindNotFound  = internal.ErrServiceReferenceKindNotFound
+	ErrReferenceNotFound      = internal.ErrServiceReferenceNotFound
+	ErrReferenceAlreadyExists = internal.ErrServiceReferenceAlreadyExists
+	ErrInvalidReferenceValue  = internal.ErrServiceInvalidReferenceValue
+
+	ErrWebhookNotFound           = internal.ErrServiceWebhookNotFound
+	ErrWebhookDeadLetterNotFound = internal.ErrServiceWebhookDeadLetterNotFound
+	ErrInvalidWebhookURL         = internal.ErrServiceInvalidWebhookURL
+	ErrInvalidWebhookSecret      = internal.ErrServiceInvalidWebhookSecret
+	ErrInvalidWebhookEvents      = internal.ErrServiceInvalidWebhookEvents
+
+	// ErrEventsExpired is returned by Events when the events to resume from were discarded.
+	ErrEventsExpired = internal.ErrEventBusVehicleEventsExpired
+	// ErrInvalidInterval is returned by ParseInterval when an interval is not valid.
+	ErrInvalidInterval = internal.ErrInvalidInterval
+)
+
+// serviceErrors are the service errors of the codes of the error responses.
+var serviceErrors = map[string]error{
+	"vehicles_not_found":                 ErrVehiclesNotFound,
+	"invalid_vehicle_brand":              ErrInvalidVehicleBrand,
+	"invalid_vehicle_model":              ErrInvalidVehicleModel,
+	"invalid_vehicle_registration":       ErrInvalidVehicleRegistration,
+	"invalid_vehicle_year":               ErrInvalidVehicleYear,
+	"invalid_vehicle_color":              ErrInvalidVehicleColor,
+	"invalid_vehicle_max_speed":          ErrInvalidVehicleMaxSpeed,
+	"invalid_vehicle_fuel_type":          ErrInvalidVehicleFuelType,
+	"invalid_vehicle_transmission":       ErrInvalidVehicleTransmission,
+	"invalid_vehicle_passengers":         ErrInvalidVehiclePassengers,
+	"invalid_vehicle_height":             ErrInvalidVehicleHeight,
+	"invalid_vehicle_width":              ErrInvalidVehicleWidth,
+	"invalid_vehicle_weight":             ErrInvalidVehicleWeight,
+	"invalid_vehicle_battery_capacity":   ErrInvalidVehicleBatteryCapacity,
+	"invalid_vehicle_range":              ErrInvalidVehicleRange,
+	"invalid_vehicle_charging_connector": ErrInvalidVehicleChargingConnector,
+	"invalid_vehicle_owner":              ErrInvalidVehicleOwner,
+	"vehicle_already_exists":             ErrVehicleIdAlreadyExists,
+	"vehicle_not_found":                  ErrVehicleNotFound,
+	"invalid_stats_group_by":             ErrInvalidStatsGroupBy,
+	"invalid_stats_metric":               ErrInvalidStatsMetric,
+	"invalid_histogram_attribute":        ErrInvalidHistogramAttribute,
+	"invalid_histogram_buckets":          ErrInvalidHistogramBuckets,
+	"invalid_histogram_filter":           ErrInvalidHistogramFilter,
+	"invalid_histogram_group_by":         ErrInvalidHistogramGroupBy,
+	"invalid_search_query":               ErrInvalidSearchQuery,
+	"invalid_search_limit":               ErrInvalidSearchLimit,
+	"invalid_similar_weights":            ErrInvalidSimilarWeights,
+	"invalid_similar_limit":              ErrInvalidSimilarLimit,
+
+	"owner_not_found":     ErrOwnerNotFound,
+	"owner_has_vehicles":  ErrOwnerHasVehicles,
+	"invalid_owner_name":  ErrInvalidOwnerName,
+	"invalid_owner_email": ErrInvalidOwnerEmail,
+	"invalid_owner_phone": ErrInvalidOwnerPhone,
+
+	"fleet_not_found":           ErrFleetNotFound,
+	"fleet_name_already_exists": ErrFleetNameAlreadyExists,
+	"fleet_vehicle_not_found":   ErrFleetVehicleNotFound,
+	"invalid_fleet_name":        ErrInvalidFleetName,
+	"invalid_fleet_vehicles":    ErrInvalidFleetVehicles,
+
+	"maintenance_not_found":         ErrMaintenanceNotFound,
+	"invalid_maintenance_date":      ErrInvalidMaintenanceDate,
+	"invalid_maintenance_odometer":  ErrInvalidMaintenanceOdometer,
+	"invalid_maintenance_type":      ErrInvalidMaintenanceType,
+	"invalid_maintenance_cost":      ErrInvalidMaintenanceCost,
+	"invalid_maintenance_intervals": ErrInvalidMaintenanceIntervals,
+	"invalid_maintenance_due_days":  ErrInvalidMaintenanceDueDays,
+
+	"reference_kind_not_found": ErrReferenceKindNotFound,
+	"reference_already_exists": ErrReferenceAlreadyExists,
+	"invalid_reference_value":  ErrInvalidReferenceValue,
+
+	"webhook_not_found":      ErrWebhookNotFound,
+	"dead_letter_not_found":  ErrWebhookDeadLetterNotFound,
+	"invalid_webhook_url":    ErrInvalidWebhookURL,
+	"invalid_webhook_secret": ErrInvalidWebhookSecret,
+	"invalid_webhook_events": ErrInvalidWebhookEvents,
+}
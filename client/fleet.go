@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// FleetJSON is an struct that represents a fleet in json format.
+type FleetJSON struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	VehicleIDs  []int     `json:"vehicle_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// bodyFleet is an struct that represents the body to create or update a fleet.
+type bodyFleet struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// deserializeFleet returns the fleet of its json representation.
+func deserializeFleet(fj FleetJSON) Fleet {
+	return Fleet{
+		ID: fj.ID,
+		Attributes: FleetAttributes{
+			Name:        fj.Name,
+			Description: fj.Description,
+		},
+		VehicleIDs: fj.VehicleIDs,
+		CreatedAt:  fj.CreatedAt,
+	}
+}
+
+// FindAllFleets returns all fleets.
+func (c *Default) FindAllFleets(ctx context.Context) (f []Fleet, err error) {
+	var fjs []FleetJSON
+	if err = c.data(ctx, request{method: http.MethodGet, path: "/fleets"}, &fjs); err != nil {
+		return
+	}
+	f = make([]Fleet, len(fjs))
+	for i, fj := range fjs {
+		f[i] = deserializeFleet(fj)
+	}
+	return
+}
+
+// FindFleetById returns a fleet.
+func (c *Default) FindFleetById(ctx context.Context, id int) (f Fleet, err error) {
+	return c.fleet(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/fleets/%d", id)})
+}
+
+// InsertFleet creates a fleet without vehicles.
+func (c *Default) InsertFleet(ctx context.Context, a FleetAttributes) (f Fleet, err error) {
+	return c.fleet(ctx, request{method: http.MethodPost, path: "/fleets", body: bodyFleet{Name: a.Name, Description: a.Description}})
+}
+
+// UpdateFleet replaces the name and description of a fleet, keeping its vehicles.
+func (c *Default) UpdateFleet(ctx context.Context, id int, a FleetAttributes) (f Fleet, err error) {
+	return c.fleet(ctx, request{method: http.MethodPut, path: fmt.Sprintf("/fleets/%d", id), body: bodyFleet{Name: a.Name, Description: a.Description}})
+}
+
+// DeleteFleet removes a fleet, its vehicles are kept.
+func (c *Default) DeleteFleet(ctx context.Context, id int) (err error) {
+	_, err = c.do(ctx, request{method: http.MethodDelete, path: fmt.Sprintf("/fleets/%d", id)})
+	return
+}
+
+// AddFleetVehicles adds existing vehicles to a fleet.
+func (c *Default) AddFleetVehicles(ctx context.Context, id int, vehicleIds []int) (f Fleet, err error) {
+	return c.fleet(ctx, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/fleets/%d/vehicles", id),
+		body:   map[string][]int{"vehicle_ids": vehicleIds},
+	})
+}
+
+// RemoveFleetVehicle removes a vehicle from a fleet, the vehicle is kept.
+func (c *Default) RemoveFleetVehicle(ctx context.Context, id int, vehicleId int) (f Fleet, err error) {
+	return c.fleet(ctx, request{method: http.MethodDelete, path: fmt.Sprintf("/fleets/%d/vehicles/%d", id, vehicleId)})
+}
+
+// FindFleetVehicles returns the vehicles of a fleet.
+func (c *Default) FindFleetVehicles(ctx context.Context, id int) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/fleets/%d/vehicles", id)})
+}
+
+// CalculateFleetAverageSpeedByBrand returns the average max speed of the vehicles of a brand within a fleet.
+func (c *Default) CalculateFleetAverageSpeedByBrand(ctx context.Context, id int, brand string) (avg float64, err error) {
+	return c.average(ctx, fmt.Sprintf("/fleets/%d/vehicles/average_speed/brand/%s", id, url.PathEscape(brand)))
+}
+
+// CalculateFleetAverageCapacityByBrand returns the average passengers of the vehicles of a brand within a fleet.
+func (c *Default) CalculateFleetAverageCapacityByBrand(ctx context.Context, id int, brand string) (avg float64, err error) {
+	return c.average(ctx, fmt.Sprintf("/fleets/%d/vehicles/average_capacity/brand/%s", id, url.PathEscape(brand)))
+}
+
+// fleet sends a request whose response data is a fleet.
+func (c *Default) fleet(ctx context.Context, r request) (f Fleet, err error) {
+	var fj FleetJSON
+	if err = c.data(ctx, r, &fj); err != nil {
+		return
+	}
+	f = deserializeFleet(fj)
+	return
+}
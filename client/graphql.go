@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// graphQLResult is an struct that represents the result of a graphql request.
+type graphQLResult struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string `json:"message"`
+		Extensions struct {
+			ErrorCode string `json:"error_code"`
+		} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// GraphQL executes a graphql query or mutation, decoding the data of its result into v (if not nil).
+// The first error of the result is returned as an *Error, unwrapping to the service error it was caused by.
+func (c *Default) GraphQL(ctx context.Context, query string, variables map[string]any, v any) (err error) {
+	rs, err := c.send(ctx, request{
+		method: http.MethodPost,
+		path:   "/graphql",
+		body:   map[string]any{"query": query, "variables": variables},
+	})
+	if err != nil {
+		return
+	}
+	defer rs.Body.Close()
+
+	var result graphQLResult
+	if err = json.NewDecoder(rs.Body).Decode(&result); err != nil {
+		err = fmt.Errorf("client: decoding graphql result: %w", err)
+		return
+	}
+	if len(result.Errors) > 0 {
+		e := result.Errors[0]
+		err = &Error{StatusCode: rs.StatusCode, Message: e.Message, Code: e.Extensions.ErrorCode, err: serviceErrors[e.Extensions.ErrorCode]}
+		return
+	}
+	if v == nil {
+		return
+	}
+	if err = json.Unmarshal(result.Data, v); err != nil {
+		err = fmt.Errorf("client: decoding graphql data: %w", err)
+		return
+	}
+	return
+}
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// maintenanceDateLayout is the layout of the dates of the maintenance records.
+const maintenanceDateLayout = time.DateOnly
+
+// MaintenanceRecordJSON is an struct that represents a maintenance record in json format.
+type MaintenanceRecordJSON struct {
+	ID        int       `json:"id"`
+	VehicleID int       `json:"vehicle_id"`
+	Date      string    `json:"date"`
+	Odometer  int       `json:"odometer"`
+	Type      string    `json:"type"`
+	Cost      float64   `json:"cost"`
+	Notes     string    `json:"notes,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MaintenanceIntervalJSON is an struct that represents a maintenance interval in json format.
+type MaintenanceIntervalJSON struct {
+	Brand      string `json:"brand,omitempty"`
+	FuelType   string `json:"fuel_type,omitempty"`
+	Type       string `json:"type"`
+	Months     int    `json:"months"`
+	Kilometers int    `json:"kilometers"`
+}
+
+// MaintenanceDueJSON is an struct that represents a maintenance a vehicle needs in json format.
+type MaintenanceDueJSON struct {
+	VehicleID          int    `json:"vehicle_id"`
+	Brand              string `json:"brand"`
+	Model              string `json:"model"`
+	Registration       string `json:"registration"`
+	FuelType           string `json:"fuel_type"`
+	Type               string `json:"type"`
+	IntervalMonths     int    `json:"interval_months,omitempty"`
+	IntervalKilometers int    `json:"interval_kilometers,omitempty"`
+	LastDate           string `json:"last_date,omitempty"`
+	LastOdometer       int    `json:"last_odometer,omitempty"`
+	Odometer           int    `json:"odometer"`
+	DueDate            string `json:"due_date,omitempty"`
+	DueOdometer        int    `json:"due_odometer,omitempty"`
+	Overdue            bool   `json:"overdue"`
+}
+
+// bodyMaintenance is an struct that represents the body to create or update a maintenance record.
+type bodyMaintenance struct {
+	Date     string  `json:"date"`
+	Odometer int     `json:"odometer"`
+	Type     string  `json:"type"`
+	Cost     float64 `json:"cost"`
+	Notes    string  `json:"notes"`
+}
+
+// serializeMaintenanceAttributes returns the body of the maintenance attributes.
+func serializeMaintenanceAttributes(a MaintenanceAttributes) bodyMaintenance {
+	return bodyMaintenance{
+		Date:     a.Date.Format(maintenanceDateLayout),
+		Odometer: a.Odometer,
+		Type:     string(a.Type),
+		Cost:     a.Cost,
+		Notes:    a.Notes,
+	}
+}
+
+// deserializeMaintenanceRecord returns the maintenance record of its json representation.
+func deserializeMaintenanceRecord(rj MaintenanceRecordJSON) (r MaintenanceRecord, err error) {
+	date, err := time.Parse(maintenanceDateLayout, rj.Date)
+	if err != nil {
+		err = fmt.Errorf("client: decoding date of maintenance record %d: %w", rj.ID, err)
+		return
+	}
+	r = MaintenanceRecord{
+		ID:        rj.ID,
+		VehicleID: rj.VehicleID,
+		Attributes: MaintenanceAttributes{
+			Date:     date,
+			Odometer: rj.Odometer,
+			Type:     MaintenanceType(rj.Type),
+			Cost:     rj.Cost,
+			Notes:    rj.Notes,
+		},
+		CreatedAt: rj.CreatedAt,
+	}
+	return
+}
+
+// FindVehicleMaintenance returns the maintenance records of a vehicle by date, of a kind of maintenance if t is not empty.
+func (c *Default) FindVehicleMaintenance(ctx context.Context, vehicleId int, t MaintenanceType) (r []MaintenanceRecord, err error) {
+	req := request{method: http.MethodGet, path: fmt.Sprintf("/vehicles/%d/maintenance", vehicleId)}
+	if t != "" {
+		req.query = url.Values{"type": {string(t)}}
+	}
+	var rjs []MaintenanceRecordJSON
+	if err = c.data(ctx, req, &rjs); err != nil {
+		return
+	}
+	r = make([]MaintenanceRecord, len(rjs))
+	for i, rj := range rjs {
+		if r[i], err = deserializeMaintenanceRecord(rj); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// FindVehicleMaintenanceById returns a maintenance record of a vehicle.
+func (c *Default) FindVehicleMaintenanceById(ctx context.Context, vehicleId int, id int) (r MaintenanceRecord, err error) {
+	return c.maintenanceRecord(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/vehicles/%d/maintenance/%d", vehicleId, id)})
+}
+
+// InsertVehicleMaintenance creates a maintenance record of a vehicle.
+func (c *Default) InsertVehicleMaintenance(ctx context.Context, vehicleId int, a MaintenanceAttributes) (r MaintenanceRecord, err error) {
+	return c.maintenanceRecord(ctx, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/vehicles/%d/maintenance", vehicleId),
+		body:   serializeMaintenanceAttributes(a),
+	})
+}
+
+// UpdateVehicleMaintenance replaces a maintenance record of a vehicle.
+func (c *Default) UpdateVehicleMaintenance(ctx context.Context, vehicleId int, id int, a MaintenanceAttributes) (r MaintenanceRecord, err error) {
+	return c.maintenanceRecord(ctx, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/vehicles/%d/maintenance/%d", vehicleId, id),
+		body:   serializeMaintenanceAttributes(a),
+	})
+}
+
+// DeleteVehicleMaintenance removes a maintenance record of a vehicle.
+func (c *Default) DeleteVehicleMaintenance(ctx context.Context, vehicleId int, id int) (err error) {
+	_, err = c.do(ctx, request{method: http.MethodDelete, path: fmt.Sprintf("/vehicles/%d/maintenance/%d", vehicleId, id)})
+	return
+}
+
+// FindMaintenanceIntervals returns the intervals the maintenance is due at.
+func (c *Default) FindMaintenanceIntervals(ctx context.Context) (i []MaintenanceInterval, err error) {
+	return c.maintenanceIntervals(ctx, request{method: http.MethodGet, path: "/vehicles/maintenance/intervals"})
+}
+
+// ReplaceMaintenanceIntervals replaces all the intervals the maintenance is due at.
+func (c *Default) ReplaceMaintenanceIntervals(ctx context.Context, i []MaintenanceInterval) (ni []MaintenanceInterval, err error) {
+	body := make([]MaintenanceIntervalJSON, len(i))
+	for n, in := range i {
+		body[n] = MaintenanceIntervalJSON{
+			Brand:      in.Brand,
+			FuelType:   in.FuelType,
+			Type:       string(in.Type),
+			Months:     in.Months,
+			Kilometers: in.Kilometers,
+		}
+	}
+	return c.maintenanceIntervals(ctx, request{method: http.MethodPut, path: "/vehicles/maintenance/intervals", body: body})
+}
+
+// FindAllMaintenanceDue returns the maintenance the vehicles need, the overdue one first.
+// Only the id, brand, model, registration and fuel type of the vehicles are set.
+func (c *Default) FindAllMaintenanceDue(ctx context.Context, q MaintenanceDueQuery) (d []MaintenanceDue, err error) {
+	query := url.Values{}
+	if !q.Date.IsZero() {
+		query.Set("date", q.Date.Format(maintenanceDateLayout))
+	}
+	if q.Days != 0 {
+		query.Set("days", strconv.Itoa(q.Days))
+	}
+	if q.Type != "" {
+		query.Set("type", string(q.Type))
+	}
+
+	var djs []MaintenanceDueJSON
+	if err = c.data(ctx, request{method: http.MethodGet, path: "/vehicles/maintenance/due", query: query}, &djs); err != nil {
+		return
+	}
+	d = make([]MaintenanceDue, len(djs))
+	for i, dj := range djs {
+		d[i] = MaintenanceDue{
+			Vehicle: Vehicle{
+				ID: dj.VehicleID,
+				Attributes: VehicleAttributes{
+					Brand:        dj.Brand,
+					Model:        dj.Model,
+					Registration: dj.Registration,
+					FuelType:     dj.FuelType,
+				},
+			},
+			Interval: MaintenanceInterval{
+				Type:       MaintenanceType(dj.Type),
+				Months:     dj.IntervalMonths,
+				Kilometers: dj.IntervalKilometers,
+			},
+			Odometer:    dj.Odometer,
+			DueOdometer: dj.DueOdometer,
+			Overdue:     dj.Overdue,
+		}
+		if dj.LastDate != "" {
+			last, e := time.Parse(maintenanceDateLayout, dj.LastDate)
+			if e != nil {
+				return nil, fmt.Errorf("client: decoding last date of maintenance due: %w", e)
+			}
+			d[i].Last = &MaintenanceRecord{
+				VehicleID:  dj.VehicleID,
+				Attributes: MaintenanceAttributes{Date: last, Odometer: dj.LastOdometer, Type: MaintenanceType(dj.Type)},
+			}
+		}
+		if dj.DueDate != "" {
+			if d[i].DueDate, err = time.Parse(maintenanceDateLayout, dj.DueDate); err != nil {
+				return nil, fmt.Errorf("client: decoding due date of maintenance due: %w", err)
+			}
+		}
+	}
+	return
+}
+
+// maintenanceRecord sends a request whose response data is a maintenance record.
+func (c *Default) maintenanceRecord(ctx context.Context, r request) (m MaintenanceRecord, err error) {
+	var rj MaintenanceRecordJSON
+	if err = c.data(ctx, r, &rj); err != nil {
+		return
+	}
+	return deserializeMaintenanceRecord(rj)
+}
+
+// maintenanceIntervals sends a request whose response data are maintenance intervals.
+func (c *Default) maintenanceIntervals(ctx context.Context, r request) (i []MaintenanceInterval, err error) {
+	var ijs []MaintenanceIntervalJSON
+	if err = c.data(ctx, r, &ijs); err != nil {
+		return
+	}
+	i = make([]MaintenanceInterval, len(ijs))
+	for n, ij := range ijs {
+		i[n] = MaintenanceInterval{
+			Brand:      ij.Brand,
+			FuelType:   ij.FuelType,
+			Type:       MaintenanceType(ij.Type),
+			Months:     ij.Months,
+			Kilometers: ij.Kilometers,
+		}
+	}
+	return
+}
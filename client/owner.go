@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// OwnerJSON is an struct that represents an owner in json format.
+type OwnerJSON struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// bodyOwner is an struct that represents the body to create or update an owner.
+type bodyOwner struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+// serializeOwnerAttributes returns the body of the owner attributes.
+func serializeOwnerAttributes(a OwnerAttributes) bodyOwner {
+	return bodyOwner{Name: a.Name, Email: a.Email, Phone: a.Phone}
+}
+
+// deserializeOwner returns the owner of its json representation.
+func deserializeOwner(oj OwnerJSON) Owner {
+	return Owner{
+		ID: oj.ID,
+		Attributes: OwnerAttributes{
+			Name:  oj.Name,
+			Email: oj.Email,
+			Phone: oj.Phone,
+		},
+		CreatedAt: oj.CreatedAt,
+	}
+}
+
+// FindAllOwners returns all owners.
+func (c *Default) FindAllOwners(ctx context.Context) (o []Owner, err error) {
+	var ojs []OwnerJSON
+	if err = c.data(ctx, request{method: http.MethodGet, path: "/owners"}, &ojs); err != nil {
+		return
+	}
+	o = make([]Owner, len(ojs))
+	for i, oj := range ojs {
+		o[i] = deserializeOwner(oj)
+	}
+	return
+}
+
+// FindOwnerById returns an owner.
+func (c *Default) FindOwnerById(ctx context.Context, id int) (o Owner, err error) {
+	return c.owner(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/owners/%d", id)})
+}
+
+// InsertOwner creates an owner.
+func (c *Default) InsertOwner(ctx context.Context, a OwnerAttributes) (o Owner, err error) {
+	return c.owner(ctx, request{method: http.MethodPost, path: "/owners", body: serializeOwnerAttributes(a)})
+}
+
+// UpdateOwner replaces the attributes of an owner.
+func (c *Default) UpdateOwner(ctx context.Context, id int, a OwnerAttributes) (o Owner, err error) {
+	return c.owner(ctx, request{method: http.MethodPut, path: fmt.Sprintf("/owners/%d", id), body: serializeOwnerAttributes(a)})
+}
+
+// DeleteOwner removes an owner, it fails while the owner has vehicles.
+func (c *Default) DeleteOwner(ctx context.Context, id int) (err error) {
+	_, err = c.do(ctx, request{method: http.MethodDelete, path: fmt.Sprintf("/owners/%d", id)})
+	return
+}
+
+// FindVehiclesByOwner returns the vehicles of an owner.
+func (c *Default) FindVehiclesByOwner(ctx context.Context, id int) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/owners/%d/vehicles", id)})
+}
+
+// owner sends a request whose response data is an owner.
+func (c *Default) owner(ctx context.Context, r request) (o Owner, err error) {
+	var oj OwnerJSON
+	if err = c.data(ctx, r, &oj); err != nil {
+		return
+	}
+	o = deserializeOwner(oj)
+	return
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// References returns the values of a controlled vocabulary, sorted.
+func (c *Default) References(ctx context.Context, k ReferenceKind) (v []string, err error) {
+	err = c.data(ctx, request{method: http.MethodGet, path: "/reference/" + url.PathEscape(string(k))}, &v)
+	return
+}
+
+// InsertReference adds a value to a controlled vocabulary, returning it as it was normalized by the api.
+func (c *Default) InsertReference(ctx context.Context, k ReferenceKind, v string) (nv string, err error) {
+	err = c.data(ctx, request{
+		method: http.MethodPost,
+		path:   "/reference/" + url.PathEscape(string(k)),
+		body:   map[string]string{"value": v},
+	}, &nv)
+	return
+}
@@ -0,0 +1,106 @@
+package client
+
+import "app/internal"
+
+// The types of the api, so the client can be used from outside of the module.
+
+// Vehicles.
+type (
+	Vehicle           = internal.Vehicle
+	VehicleAttributes = internal.VehicleAttributes
+	// VehicleFuel is the fuel type of a vehicle with its energy attributes.
+	VehicleFuel = internal.VehicleFuel
+	// Interval is a range of values of the range filters, each of its bounds inclusive, exclusive or open-ended.
+	Interval = internal.Interval
+	Bound    = internal.Bound
+	// LengthUnit and WeightUnit are the units the dimensions and weights are written in.
+	LengthUnit = internal.LengthUnit
+	WeightUnit = internal.WeightUnit
+)
+
+// ClosedInterval returns the interval between min and max, both included.
+func ClosedInterval(min, max float64) Interval {
+	return internal.ClosedInterval(min, max)
+}
+
+// ParseInterval returns the interval written in brackets (e.g. [100,200) or (,200]), the values read with parse.
+func ParseInterval(s string, parse func(s string) (float64, error)) (Interval, error) {
+	return internal.ParseInterval(s, parse)
+}
+
+// Aggregations, searches and similar vehicles.
+type (
+	VehicleStatsQuery      = internal.VehicleStatsQuery
+	VehicleStatsMetric     = internal.VehicleStatsMetric
+	VehicleStats           = internal.VehicleStats
+	VehicleHistogramQuery  = internal.VehicleHistogramQuery
+	VehicleHistogram       = internal.VehicleHistogram
+	VehicleHistogramBucket = internal.VehicleHistogramBucket
+	VehicleSearchResult    = internal.VehicleSearchResult
+	VehicleSimilarQuery    = internal.VehicleSimilarQuery
+	VehicleSimilarResult   = internal.VehicleSimilarResult
+)
+
+// Vehicle events.
+type (
+	VehicleEvent       = internal.VehicleEvent
+	VehicleEventType   = internal.VehicleEventType
+	VehicleEventFilter = internal.VehicleEventFilter
+)
+
+const (
+	VehicleEventCreated = internal.VehicleEventCreated
+	VehicleEventUpdated = internal.VehicleEventUpdated
+	VehicleEventDeleted = internal.VehicleEventDeleted
+)
+
+// Owners and fleets.
+type (
+	Owner           = internal.Owner
+	OwnerAttributes = internal.OwnerAttributes
+	Fleet           = internal.Fleet
+	FleetAttributes = internal.FleetAttributes
+)
+
+// Maintenance.
+type (
+	MaintenanceType       = internal.MaintenanceType
+	MaintenanceAttributes = internal.MaintenanceAttributes
+	MaintenanceRecord     = internal.MaintenanceRecord
+	MaintenanceInterval   = internal.MaintenanceInterval
+	MaintenanceDueQuery   = internal.MaintenanceDueQuery
+	MaintenanceDue        = internal.MaintenanceDue
+)
+
+const (
+	MaintenanceOilChange  = internal.MaintenanceOilChange
+	MaintenanceInspection = internal.MaintenanceInspection
+	MaintenanceRepair     = internal.MaintenanceRepair
+)
+
+// Controlled vocabularies.
+type ReferenceKind = internal.ReferenceKind
+
+const (
+	ReferenceFuelTypes          = internal.ReferenceFuelTypes
+	ReferenceTransmissions      = internal.ReferenceTransmissions
+	ReferenceColors             = internal.ReferenceColors
+	ReferenceBrands             = internal.ReferenceBrands
+	ReferenceChargingConnectors = internal.ReferenceChargingConnectors
+)
+
+// Webhooks.
+type (
+	Webhook           = internal.Webhook
+	WebhookAttributes = internal.WebhookAttributes
+	WebhookEventType  = internal.WebhookEventType
+	WebhookDelivery   = internal.WebhookDelivery
+	WebhookDeadLetter = internal.WebhookDeadLetter
+)
+
+const (
+	WebhookEventVehicleCreated         = internal.WebhookEventVehicleCreated
+	WebhookEventVehicleUpdated         = internal.WebhookEventVehicleUpdated
+	WebhookEventVehicleFuelTypeChanged = internal.WebhookEventVehicleFuelTypeChanged
+	WebhookEventVehicleDeleted         = internal.WebhookEventVehicleDeleted
+)
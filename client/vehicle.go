@@ -0,0 +1,449 @@
+package client
+
+import (
+	"app/internal"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// VehicleJSON is an struct that represents a vehicle in json format.
+type VehicleJSON struct {
+	ID                int     `json:"id,omitempty"`
+	Brand             string  `json:"brand"`
+	Model             string  `json:"model"`
+	Registration      string  `json:"registration"`
+	Year              int     `json:"year"`
+	Color             string  `json:"color"`
+	MaxSpeed          int     `json:"max_speed"`
+	FuelType          string  `json:"fuel_type"`
+	Transmission      string  `json:"transmission"`
+	Passengers        int     `json:"passengers"`
+	Height            float64 `json:"height"`
+	Width             float64 `json:"width"`
+	Weight            float64 `json:"weight"`
+	BatteryCapacity   float64 `json:"battery_capacity,omitempty"`
+	Range             int     `json:"range,omitempty"`
+	ChargingConnector string  `json:"charging_connector,omitempty"`
+	// LengthUnit and WeightUnit are the units of the dimensions and weight, the ones of the vehicles if empty.
+	LengthUnit LengthUnit `json:"length_unit,omitempty"`
+	WeightUnit WeightUnit `json:"weight_unit,omitempty"`
+	// OwnerID is the owner of the vehicle, none if it is 0.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Source is the data source the vehicle was loaded from, it is not sent when the vehicle is created.
+	Source string `json:"source,omitempty"`
+}
+
+// bodyFuel is an struct that represents the body to update the fuel type of a vehicle.
+type bodyFuel struct {
+	FuelType          string  `json:"fuel_type"`
+	BatteryCapacity   float64 `json:"battery_capacity,omitempty"`
+	Range             int     `json:"range,omitempty"`
+	ChargingConnector string  `json:"charging_connector,omitempty"`
+}
+
+// serializeVehicle returns the json representation of a vehicle, without id so it can be created.
+func serializeVehicle(v Vehicle) VehicleJSON {
+	return VehicleJSON{
+		Brand:             v.Attributes.Brand,
+		Model:             v.Attributes.Model,
+		Registration:      v.Attributes.Registration,
+		Year:              v.Attributes.Year,
+		Color:             v.Attributes.Color,
+		MaxSpeed:          v.Attributes.MaxSpeed,
+		FuelType:          v.Attributes.FuelType,
+		Transmission:      v.Attributes.Transmission,
+		Passengers:        v.Attributes.Passengers,
+		Height:            v.Attributes.Height,
+		Width:             v.Attributes.Width,
+		Weight:            v.Attributes.Weight,
+		BatteryCapacity:   v.Attributes.BatteryCapacity,
+		Range:             v.Attributes.Range,
+		ChargingConnector: v.Attributes.ChargingConnector,
+		OwnerID:           v.OwnerID,
+	}
+}
+
+// deserializeVehicle returns the vehicle of its json representation, with the dimensions and weight in the units of the vehicles.
+func deserializeVehicle(vj VehicleJSON) Vehicle {
+	lu, wu := vj.LengthUnit, vj.WeightUnit
+	if !lu.Valid() {
+		lu = internal.VehicleLengthUnit
+	}
+	if !wu.Valid() {
+		wu = internal.VehicleWeightUnit
+	}
+	return Vehicle{
+		ID: vj.ID,
+		Attributes: VehicleAttributes{
+			Brand:             vj.Brand,
+			Model:             vj.Model,
+			Registration:      vj.Registration,
+			Year:              vj.Year,
+			Color:             vj.Color,
+			MaxSpeed:          vj.MaxSpeed,
+			FuelType:          vj.FuelType,
+			Transmission:      vj.Transmission,
+			Passengers:        vj.Passengers,
+			Height:            lu.Convert(vj.Height, internal.VehicleLengthUnit),
+			Width:             lu.Convert(vj.Width, internal.VehicleLengthUnit),
+			Weight:            wu.Convert(vj.Weight, internal.VehicleWeightUnit),
+			BatteryCapacity:   vj.BatteryCapacity,
+			Range:             vj.Range,
+			ChargingConnector: vj.ChargingConnector,
+		},
+		OwnerID: vj.OwnerID,
+		Source:  vj.Source,
+	}
+}
+
+// FindAll returns all vehicles.
+func (c *Default) FindAll(ctx context.Context) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{method: http.MethodGet, path: "/vehicles"})
+}
+
+// Insert creates a vehicle, its id is assigned by the api.
+func (c *Default) Insert(ctx context.Context, v Vehicle) (nv Vehicle, err error) {
+	return c.vehicle(ctx, request{method: http.MethodPost, path: "/vehicles", body: serializeVehicle(v)})
+}
+
+// FindAllByColorAndYear returns the vehicles with the color made in the year.
+func (c *Default) FindAllByColorAndYear(ctx context.Context, color string, year int) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/vehicles/color/%s/year/%d", url.PathEscape(color), year),
+	})
+}
+
+// FindAllByBrandAndBetweenYears returns the vehicles of the brand made between the years, both included.
+func (c *Default) FindAllByBrandAndBetweenYears(ctx context.Context, brand string, startYear int, endYear int) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/vehicles/brand/%s/between/%d/%d", url.PathEscape(brand), startYear, endYear),
+	})
+}
+
+// CalculateAverageSpeedByBrand returns the average max speed of the vehicles of the brand.
+func (c *Default) CalculateAverageSpeedByBrand(ctx context.Context, brand string) (avg float64, err error) {
+	return c.average(ctx, "/vehicles/average_speed/brand/"+url.PathEscape(brand))
+}
+
+// InsertMany creates the vehicles, either all of them or none.
+func (c *Default) InsertMany(ctx context.Context, v []Vehicle) (nvs []Vehicle, err error) {
+	body := make([]VehicleJSON, len(v))
+	for i := range v {
+		body[i] = serializeVehicle(v[i])
+	}
+	return c.vehicles(ctx, request{method: http.MethodPost, path: "/vehicles/batch", body: body})
+}
+
+// UpdateMaxSpeedById updates the max speed of a vehicle.
+func (c *Default) UpdateMaxSpeedById(ctx context.Context, id int, maxSpeed int) (uv Vehicle, err error) {
+	return c.vehicle(ctx, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/vehicles/%d/update_speed", id),
+		body:   map[string]int{"max_speed": maxSpeed},
+	})
+}
+
+// FindAllByFuelType returns the vehicles with the fuel type.
+func (c *Default) FindAllByFuelType(ctx context.Context, fuelType string) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{method: http.MethodGet, path: "/vehicles/fuel_type/" + url.PathEscape(fuelType)})
+}
+
+// Delete removes a vehicle.
+func (c *Default) Delete(ctx context.Context, id int) (err error) {
+	_, err = c.do(ctx, request{method: http.MethodDelete, path: fmt.Sprintf("/vehicles/%d", id)})
+	return
+}
+
+// FindAllByTransmission returns the vehicles with the transmission.
+func (c *Default) FindAllByTransmission(ctx context.Context, transmission string) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{method: http.MethodGet, path: "/vehicles/transmission/" + url.PathEscape(transmission)})
+}
+
+// UpdateFuelTypeById updates the fuel type of a vehicle, the energy attributes of f replacing the ones it had.
+func (c *Default) UpdateFuelTypeById(ctx context.Context, id int, f VehicleFuel) (uv Vehicle, err error) {
+	return c.vehicle(ctx, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/vehicles/%d/update_fuel", id),
+		body:   bodyFuel(f),
+	})
+}
+
+// UpdateOwnerById sets the owner of a vehicle, 0 removing it.
+func (c *Default) UpdateOwnerById(ctx context.Context, id int, ownerId int) (uv Vehicle, err error) {
+	body := map[string]*int{"owner_id": nil}
+	if ownerId != 0 {
+		body["owner_id"] = &ownerId
+	}
+	return c.vehicle(ctx, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/vehicles/%d/owner", id),
+		body:   body,
+	})
+}
+
+// CalculateAverageCapacityByBrand returns the average passengers of the vehicles of the brand.
+func (c *Default) CalculateAverageCapacityByBrand(ctx context.Context, brand string) (avg float64, err error) {
+	return c.average(ctx, "/vehicles/average_capacity/brand/"+url.PathEscape(brand))
+}
+
+// FindAllByDimensions returns the vehicles with height and width within the intervals, in cm.
+func (c *Default) FindAllByDimensions(ctx context.Context, height, width Interval) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{
+		method: http.MethodGet,
+		path:   "/vehicles/dimensions",
+		query:  url.Values{"height": {height.String()}, "width": {width.String()}},
+	})
+}
+
+// FindAllByWeight returns the vehicles with weight within the interval, in kg.
+func (c *Default) FindAllByWeight(ctx context.Context, weight Interval) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{
+		method: http.MethodGet,
+		path:   "/vehicles/weight",
+		query:  url.Values{"weight": {weight.String()}},
+	})
+}
+
+// FindAllByChargingConnector returns the vehicles with the charging connector.
+func (c *Default) FindAllByChargingConnector(ctx context.Context, connector string) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{method: http.MethodGet, path: "/vehicles/charging_connector/" + url.PathEscape(connector)})
+}
+
+// FindAllByRange returns the electric and hybrid vehicles with range within the interval, in km.
+func (c *Default) FindAllByRange(ctx context.Context, r Interval) (v []Vehicle, err error) {
+	return c.vehicles(ctx, request{
+		method: http.MethodGet,
+		path:   "/vehicles/range",
+		query:  url.Values{"range": {r.String()}},
+	})
+}
+
+// CalculateStats returns the metrics of each group of vehicles, calculated by the api in a single pass.
+// The count of the groups is only set if the count metric is requested (the default).
+func (c *Default) CalculateStats(ctx context.Context, q VehicleStatsQuery) (s []VehicleStats, err error) {
+	query := url.Values{}
+	if len(q.GroupBy) > 0 {
+		query.Set("group_by", strings.Join(q.GroupBy, ","))
+	}
+	if len(q.Metrics) > 0 {
+		metrics := make([]string, len(q.Metrics))
+		for i, m := range q.Metrics {
+			metrics[i] = m.Aggregate
+			if m.Attribute != "" {
+				metrics[i] += ":" + m.Attribute
+			}
+		}
+		query.Set("metrics", strings.Join(metrics, ","))
+	} else {
+		q.Metrics = []VehicleStatsMetric{{Aggregate: "count"}}
+	}
+
+	var records []map[string]any
+	if err = c.data(ctx, request{method: http.MethodGet, path: "/vehicles/stats", query: query}, &records); err != nil {
+		return
+	}
+
+	// the records have a field per group attribute and per metric
+	s = make([]VehicleStats, len(records))
+	for i, record := range records {
+		s[i].Group = make([]string, len(q.GroupBy))
+		for j, g := range q.GroupBy {
+			s[i].Group[j], _ = record[g].(string)
+		}
+		s[i].Values = make([]float64, len(q.Metrics))
+		for j, m := range q.Metrics {
+			s[i].Values[j], _ = record[m.Name()].(float64)
+			if m.Aggregate == "count" {
+				s[i].Count = int(s[i].Values[j])
+			}
+		}
+	}
+	return
+}
+
+// CalculateHistogram returns the distribution of a numeric attribute in each group of the vehicles matching the filter,
+// calculated by the api.
+func (c *Default) CalculateHistogram(ctx context.Context, q VehicleHistogramQuery) (h []VehicleHistogram, err error) {
+	query := url.Values{}
+	query.Set("field", q.Attribute)
+	if len(q.Edges) > 0 {
+		edges := make([]string, len(q.Edges))
+		for i, e := range q.Edges {
+			edges[i] = strconv.FormatFloat(e, 'f', -1, 64)
+		}
+		query.Set("edges", strings.Join(edges, ","))
+	} else {
+		query.Set("buckets", strconv.Itoa(q.Buckets))
+	}
+	for attr, v := range q.Filter {
+		query.Set(attr, v)
+	}
+	if len(q.GroupBy) > 0 {
+		query.Set("group_by", strings.Join(q.GroupBy, ","))
+	}
+
+	var records []map[string]any
+	if err = c.data(ctx, request{method: http.MethodGet, path: "/vehicles/histogram", query: query}, &records); err != nil {
+		// - the message of an invalid group by is the same as the one of the stats
+		var e *Error
+		if errors.As(err, &e) && errors.Is(e.err, ErrInvalidStatsGroupBy) {
+			e.err = ErrInvalidHistogramGroupBy
+		}
+		return
+	}
+
+	// the records are the buckets of the groups, in order, with a field per group attribute, min, max and count
+	for _, record := range records {
+		group := make([]string, len(q.GroupBy))
+		for j, g := range q.GroupBy {
+			group[j], _ = record[g].(string)
+		}
+		if len(h) == 0 || !slices.Equal(h[len(h)-1].Group, group) {
+			h = append(h, VehicleHistogram{Group: group})
+		}
+		var b VehicleHistogramBucket
+		b.Min, _ = record["min"].(float64)
+		b.Max, _ = record["max"].(float64)
+		count, _ := record["count"].(float64)
+		b.Count = int(count)
+		h[len(h)-1].Buckets = append(h[len(h)-1].Buckets, b)
+	}
+	return
+}
+
+// Search returns the vehicles matching any word of a text in the brand, model, color or registration, with typos,
+// from the most to the least relevant, at most limit of them if limit is greater than 0.
+func (c *Default) Search(ctx context.Context, q string, limit int) (s []VehicleSearchResult, err error) {
+	var results []struct {
+		VehicleJSON
+		Score float64 `json:"score"`
+	}
+	err = c.data(ctx, request{
+		method: http.MethodGet,
+		path:   "/vehicles/search",
+		query:  url.Values{"q": {q}, "limit": {strconv.Itoa(limit)}},
+	}, &results)
+	if err != nil {
+		return
+	}
+
+	s = make([]VehicleSearchResult, len(results))
+	for i, r := range results {
+		s[i] = VehicleSearchResult{Vehicle: deserializeVehicle(r.VehicleJSON), Score: r.Score}
+	}
+	return
+}
+
+// FindAllSimilar returns the other vehicles from the most to the least like a vehicle, at most q.Limit of them
+// if it is greater than 0. The weights of the query replace the default weights of their attributes.
+func (c *Default) FindAllSimilar(ctx context.Context, q VehicleSimilarQuery) (s []VehicleSimilarResult, err error) {
+	weights := make([]string, 0, len(q.Weights))
+	for name, w := range q.Weights {
+		weights = append(weights, name+":"+formatFloat(w))
+	}
+	slices.Sort(weights)
+	var results []struct {
+		VehicleJSON
+		Distance float64 `json:"distance"`
+	}
+	err = c.data(ctx, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/vehicles/%d/similar", q.ID),
+		query:  url.Values{"weights": {strings.Join(weights, ",")}, "limit": {strconv.Itoa(q.Limit)}},
+	}, &results)
+	if err != nil {
+		return
+	}
+
+	s = make([]VehicleSimilarResult, len(results))
+	for i, r := range results {
+		s[i] = VehicleSimilarResult{Vehicle: deserializeVehicle(r.VehicleJSON), Distance: r.Distance}
+	}
+	return
+}
+
+// ForEach calls fn with each vehicle as it is read from the export, without loading the whole list,
+// until fn returns an error.
+func (c *Default) ForEach(ctx context.Context, fn func(v Vehicle) (err error)) (err error) {
+	rs, err := c.send(ctx, request{
+		method: http.MethodGet,
+		path:   "/vehicles/export",
+		query:  url.Values{"format": {"ndjson"}},
+		accept: "application/x-ndjson",
+	})
+	if err != nil {
+		return
+	}
+	defer rs.Body.Close()
+
+	sc := bufio.NewScanner(rs.Body)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		if len(strings.TrimSpace(sc.Text())) == 0 {
+			continue
+		}
+		var vj VehicleJSON
+		if err = json.Unmarshal(sc.Bytes(), &vj); err != nil {
+			err = fmt.Errorf("client: decoding exported vehicle: %w", err)
+			return
+		}
+		if err = fn(deserializeVehicle(vj)); err != nil {
+			return
+		}
+	}
+	err = sc.Err()
+	return
+}
+
+// vehicle sends a request whose response data is a vehicle.
+func (c *Default) vehicle(ctx context.Context, r request) (v Vehicle, err error) {
+	var vj VehicleJSON
+	if err = c.data(ctx, r, &vj); err != nil {
+		return
+	}
+	v = deserializeVehicle(vj)
+	return
+}
+
+// vehicles sends a request whose response data is a list of vehicles.
+func (c *Default) vehicles(ctx context.Context, r request) (v []Vehicle, err error) {
+	var vjs []VehicleJSON
+	if err = c.data(ctx, r, &vjs); err != nil {
+		return
+	}
+	v = make([]Vehicle, len(vjs))
+	for i, vj := range vjs {
+		v[i] = deserializeVehicle(vj)
+	}
+	return
+}
+
+// average sends a request whose response message ends with an average (e.g. "... is 120.50").
+func (c *Default) average(ctx context.Context, path string) (avg float64, err error) {
+	e, err := c.do(ctx, request{method: http.MethodGet, path: path})
+	if err != nil {
+		return
+	}
+
+	avg, err = strconv.ParseFloat(e.Message[strings.LastIndex(e.Message, " ")+1:], 64)
+	if err != nil {
+		err = fmt.Errorf("client: decoding average of %q: %w", e.Message, err)
+		return
+	}
+	return
+}
+
+// formatFloat returns the shortest representation of a float for a query param.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
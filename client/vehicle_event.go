@@ -0,0 +1,110 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VehicleEventJSON is an struct that represents a vehicle event in json format.
+type VehicleEventJSON struct {
+	ID        int64        `json:"id"`
+	Type      string       `json:"type"`
+	VehicleID int          `json:"vehicle_id"`
+	Changes   []string     `json:"changes"`
+	Vehicle   *VehicleJSON `json:"vehicle,omitempty"`
+	Time      time.Time    `json:"time"`
+}
+
+// deserializeVehicleEvent returns the vehicle event of its json representation.
+func deserializeVehicleEvent(ej VehicleEventJSON) VehicleEvent {
+	e := VehicleEvent{
+		ID:        ej.ID,
+		Type:      VehicleEventType(ej.Type),
+		VehicleID: ej.VehicleID,
+		Changes:   ej.Changes,
+		Vehicle:   Vehicle{ID: ej.VehicleID},
+		Time:      ej.Time,
+	}
+	if ej.Vehicle != nil {
+		e.Vehicle = deserializeVehicle(*ej.Vehicle)
+	}
+	return e
+}
+
+// Events calls fn with each vehicle event matching the filter after lastId (0 for only the new ones),
+// until the context is done, the api closes the stream or fn returns an error.
+// If the events after lastId were discarded, ErrEventsExpired is returned
+// so the vehicles can be fetched again before subscribing from the last event.
+func (c *Default) Events(ctx context.Context, f VehicleEventFilter, lastId int64, fn func(e VehicleEvent) (err error)) (err error) {
+	query := url.Values{}
+	if len(f.Types) > 0 {
+		types := make([]string, len(f.Types))
+		for i, t := range f.Types {
+			types[i] = string(t)
+		}
+		query.Set("type", strings.Join(types, ","))
+	}
+	if len(f.VehicleIDs) > 0 {
+		ids := make([]string, len(f.VehicleIDs))
+		for i, id := range f.VehicleIDs {
+			ids[i] = strconv.Itoa(id)
+		}
+		query.Set("vehicle_id", strings.Join(ids, ","))
+	}
+	if len(f.Changes) > 0 {
+		query.Set("changes", strings.Join(f.Changes, ","))
+	}
+	if lastId > 0 {
+		query.Set("last_event_id", strconv.FormatInt(lastId, 10))
+	}
+
+	rs, err := c.send(ctx, request{method: http.MethodGet, path: "/vehicles/events", query: query, accept: "text/event-stream"})
+	if err != nil {
+		return
+	}
+	defer rs.Body.Close()
+
+	// server-sent events are separated by blank lines, comments (heartbeats) start with a colon
+	var event string
+	var data []byte
+	sc := bufio.NewScanner(rs.Body)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case line == "":
+			if event == "reset" {
+				err = ErrEventsExpired
+				return
+			}
+			if len(data) > 0 {
+				var ej VehicleEventJSON
+				if err = json.Unmarshal(data, &ej); err != nil {
+					err = fmt.Errorf("client: decoding vehicle event: %w", err)
+					return
+				}
+				if err = fn(deserializeVehicleEvent(ej)); err != nil {
+					return
+				}
+			}
+			event, data = "", nil
+		case strings.HasPrefix(line, ":"):
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimSpace(strings.TrimPrefix(line, "data:"))...)
+		}
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	err = sc.Err()
+	return
+}
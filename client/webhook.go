@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookJSON is an struct that represents a webhook in json format.
+type WebhookJSON struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryJSON is an struct that represents an attempt to send an event in json format.
+type WebhookDeliveryJSON struct {
+	ID         int       `json:"id"`
+	Event      string    `json:"event"`
+	EventID    int64     `json:"event_id"`
+	Attempt    int       `json:"attempt"`
+	Success    bool      `json:"success"`
+	StatusCode int       `json:"status_code"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	Time       time.Time `json:"time"`
+}
+
+// WebhookDeadLetterJSON is an struct that represents an event that could not be sent in json format.
+type WebhookDeadLetterJSON struct {
+	ID        int             `json:"id"`
+	Event     string          `json:"event"`
+	EventID   int64           `json:"event_id"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempts  int             `json:"attempts"`
+	LastError string          `json:"last_error"`
+	Time      time.Time       `json:"time"`
+}
+
+// bodyWebhook is an struct that represents the body to create or update a webhook.
+type bodyWebhook struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+// serializeWebhookAttributes returns the body of the webhook attributes.
+func serializeWebhookAttributes(a WebhookAttributes) bodyWebhook {
+	b := bodyWebhook{URL: a.URL, Secret: a.Secret, Events: make([]string, len(a.Events)), Active: a.Active}
+	for i, e := range a.Events {
+		b.Events[i] = string(e)
+	}
+	return b
+}
+
+// deserializeWebhook returns the webhook of its json representation.
+func deserializeWebhook(wj WebhookJSON) Webhook {
+	w := Webhook{
+		ID: wj.ID,
+		Attributes: WebhookAttributes{
+			URL:    wj.URL,
+			Secret: wj.Secret,
+			Events: make([]WebhookEventType, len(wj.Events)),
+			Active: wj.Active,
+		},
+		CreatedAt: wj.CreatedAt,
+	}
+	for i, e := range wj.Events {
+		w.Attributes.Events[i] = WebhookEventType(e)
+	}
+	return w
+}
+
+// FindAllWebhooks returns all webhooks, without their secrets.
+func (c *Default) FindAllWebhooks(ctx context.Context) (w []Webhook, err error) {
+	var wjs []WebhookJSON
+	if err = c.data(ctx, request{method: http.MethodGet, path: "/webhooks"}, &wjs); err != nil {
+		return
+	}
+	w = make([]Webhook, len(wjs))
+	for i, wj := range wjs {
+		w[i] = deserializeWebhook(wj)
+	}
+	return
+}
+
+// FindWebhookById returns a webhook, without its secret.
+func (c *Default) FindWebhookById(ctx context.Context, id int) (w Webhook, err error) {
+	return c.webhook(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/webhooks/%d", id)})
+}
+
+// InsertWebhook creates a webhook, the returned one has its secret (generated by the api if it is empty).
+func (c *Default) InsertWebhook(ctx context.Context, a WebhookAttributes) (w Webhook, err error) {
+	return c.webhook(ctx, request{method: http.MethodPost, path: "/webhooks", body: serializeWebhookAttributes(a)})
+}
+
+// UpdateWebhook replaces the attributes of a webhook, an empty secret keeps the current one.
+func (c *Default) UpdateWebhook(ctx context.Context, id int, a WebhookAttributes) (w Webhook, err error) {
+	return c.webhook(ctx, request{method: http.MethodPut, path: fmt.Sprintf("/webhooks/%d", id), body: serializeWebhookAttributes(a)})
+}
+
+// DeleteWebhook removes a webhook.
+func (c *Default) DeleteWebhook(ctx context.Context, id int) (err error) {
+	_, err = c.do(ctx, request{method: http.MethodDelete, path: fmt.Sprintf("/webhooks/%d", id)})
+	return
+}
+
+// FindWebhookDeliveries returns the last attempts to send events to a webhook, newest first.
+func (c *Default) FindWebhookDeliveries(ctx context.Context, id int) (d []WebhookDelivery, err error) {
+	var djs []WebhookDeliveryJSON
+	if err = c.data(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/webhooks/%d/deliveries", id)}, &djs); err != nil {
+		return
+	}
+	d = make([]WebhookDelivery, len(djs))
+	for i, dj := range djs {
+		d[i] = WebhookDelivery{
+			ID:         dj.ID,
+			WebhookID:  id,
+			Event:      WebhookEventType(dj.Event),
+			EventID:    dj.EventID,
+			Attempt:    dj.Attempt,
+			StatusCode: dj.StatusCode,
+			Error:      dj.Error,
+			Duration:   time.Duration(dj.DurationMs) * time.Millisecond,
+			Time:       dj.Time,
+		}
+	}
+	return
+}
+
+// FindWebhookDeadLetters returns the events that could not be sent to a webhook.
+func (c *Default) FindWebhookDeadLetters(ctx context.Context, id int) (dl []WebhookDeadLetter, err error) {
+	var dljs []WebhookDeadLetterJSON
+	if err = c.data(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/webhooks/%d/dead_letters", id)}, &dljs); err != nil {
+		return
+	}
+	dl = make([]WebhookDeadLetter, len(dljs))
+	for i, dlj := range dljs {
+		dl[i] = WebhookDeadLetter{
+			ID:        dlj.ID,
+			WebhookID: id,
+			Event:     WebhookEventType(dlj.Event),
+			EventID:   dlj.EventID,
+			Payload:   dlj.Payload,
+			Attempts:  dlj.Attempts,
+			LastError: dlj.LastError,
+			Time:      dlj.Time,
+		}
+	}
+	return
+}
+
+// RetryWebhookDeadLetter sends a dead letter again, in the background.
+func (c *Default) RetryWebhookDeadLetter(ctx context.Context, id int, deadLetterId int) (err error) {
+	_, err = c.do(ctx, request{method: http.MethodPost, path: fmt.Sprintf("/webhooks/%d/dead_letters/%d/retry", id, deadLetterId)})
+	return
+}
+
+// webhook sends a request whose response data is a webhook.
+func (c *Default) webhook(ctx context.Context, r request) (w Webhook, err error) {
+	var wj WebhookJSON
+	if err = c.data(ctx, r, &wj); err != nil {
+		return
+	}
+	w = deserializeWebhook(wj)
+	return
+}
@@ -4,6 +4,7 @@ import (
 	"app/internal/application"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -15,8 +16,11 @@ func main() {
 	// app
 	// - config
 	cfg := &application.ConfigDefaultInMemory{
-		FileLoader: os.Getenv("PATH_FILE_LOADER_VEHICLES"),
-		Addr:       os.Getenv("SERVER_ADDR"),
+		// - one or more paths (or glob patterns) separated by commas
+		FileLoaders:    strings.FieldsFunc(os.Getenv("PATH_FILE_LOADER_VEHICLES"), func(r rune) bool { return r == ',' }),
+		ConflictPolicy: os.Getenv("LOADER_CONFLICT_POLICY"),
+		Addr:           os.Getenv("SERVER_ADDR"),
+		GRPCAddr:       os.Getenv("GRPC_SERVER_ADDR"),
 	}
 	// - app
 	app := application.NewDefaultInMemory(cfg)
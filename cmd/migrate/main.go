@@ -0,0 +1,35 @@
+package main
+
+import (
+	"app/internal/loader"
+	"fmt"
+	"os"
+)
+
+// migrate upgrades data files of vehicles in place to the current schema version.
+// usage: go run ./cmd/migrate <file> [<file> ...]
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println("usage: migrate <file> [<file> ...]")
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, path := range os.Args[1:] {
+		from, err := loader.NewVehicleJSON(path).Migrate()
+		if err != nil {
+			fmt.Printf("%s: %v\n", path, err)
+			failed = true
+			continue
+		}
+		if from == loader.SchemaVersion {
+			fmt.Printf("%s: already at schema version %d\n", path, from)
+			continue
+		}
+		fmt.Printf("%s: migrated from schema version %d to %d\n", path, from, loader.SchemaVersion)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
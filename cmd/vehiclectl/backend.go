@@ -0,0 +1,160 @@
+package main
+
+import (
+	"app/internal"
+	"app/internal/loader"
+	"app/internal/repository"
+	"app/internal/service"
+	"context"
+	"errors"
+)
+
+// backend is the interface that wraps the vehicle operations of the commands,
+// implemented by the api client (remote) and by a service over a data file (local).
+type backend interface {
+	FindAll(ctx context.Context) (v []internal.Vehicle, err error)
+	Insert(ctx context.Context, v internal.Vehicle) (nv internal.Vehicle, err error)
+	InsertMany(ctx context.Context, v []internal.Vehicle) (nvs []internal.Vehicle, err error)
+	FindAllByColorAndYear(ctx context.Context, color string, year int) (v []internal.Vehicle, err error)
+	FindAllByBrandAndBetweenYears(ctx context.Context, brand string, startYear int, endYear int) (v []internal.Vehicle, err error)
+	FindAllByFuelType(ctx context.Context, fuelType string) (v []internal.Vehicle, err error)
+	FindAllByTransmission(ctx context.Context, transmission string) (v []internal.Vehicle, err error)
+	FindAllByDimensions(ctx context.Context, height, width internal.Interval) (v []internal.Vehicle, err error)
+	FindAllByWeight(ctx context.Context, weight internal.Interval) (v []internal.Vehicle, err error)
+	FindAllByChargingConnector(ctx context.Context, connector string) (v []internal.Vehicle, err error)
+	FindAllByRange(ctx context.Context, r internal.Interval) (v []internal.Vehicle, err error)
+	UpdateMaxSpeedById(ctx context.Context, id int, maxSpeed int) (uv internal.Vehicle, err error)
+	UpdateFuelTypeById(ctx context.Context, id int, f internal.VehicleFuel) (uv internal.Vehicle, err error)
+	Delete(ctx context.Context, id int) (err error)
+	CalculateAverageSpeedByBrand(ctx context.Context, brand string) (avg float64, err error)
+	CalculateAverageCapacityByBrand(ctx context.Context, brand string) (avg float64, err error)
+}
+
+// newLocal returns a backend that works on the vehicles of a data file, saving them after each change.
+func newLocal(path string) (l *local, err error) {
+	file := loader.NewVehicleJSON(path)
+	data, err := file.Load()
+	if err != nil {
+		return
+	}
+
+	l = &local{
+		sv:     service.NewDefault(repository.NewVehicleSlice(data.Data, data.LastId), nil, nil, nil),
+		saver:  file,
+		lastId: data.LastId,
+	}
+	return
+}
+
+// local is an struct that implements the backend with the vehicle service over a data file.
+type local struct {
+	sv *service.Default
+	// saver is where the vehicles are saved after each change.
+	saver internal.Saver
+	// lastId is the last id assigned, kept so deleted ids are not reused.
+	lastId int
+}
+
+// save writes the vehicles of the service to the data file.
+func (l *local) save() (err error) {
+	data := internal.LoadData{Data: make([]internal.Vehicle, 0), LastId: l.lastId}
+	err = l.sv.ForEach(func(v internal.Vehicle) (err error) {
+		data.Data = append(data.Data, v)
+		if v.ID > data.LastId {
+			data.LastId = v.ID
+		}
+		return
+	})
+	// - the file is left without vehicles when the last one is deleted
+	if err != nil && !errors.Is(err, internal.ErrServiceVehiclesNotFound) {
+		return
+	}
+
+	l.lastId = data.LastId
+	err = l.saver.Save(data)
+	return
+}
+
+func (l *local) FindAll(ctx context.Context) (v []internal.Vehicle, err error) {
+	return l.sv.FindAll()
+}
+
+func (l *local) Insert(ctx context.Context, v internal.Vehicle) (nv internal.Vehicle, err error) {
+	if nv, err = l.sv.Insert(v); err != nil {
+		return
+	}
+	err = l.save()
+	return
+}
+
+func (l *local) InsertMany(ctx context.Context, v []internal.Vehicle) (nvs []internal.Vehicle, err error) {
+	if nvs, err = l.sv.InsertMany(v); err != nil {
+		return
+	}
+	err = l.save()
+	return
+}
+
+func (l *local) FindAllByColorAndYear(ctx context.Context, color string, year int) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByColorAndYear(color, year)
+}
+
+func (l *local) FindAllByBrandAndBetweenYears(ctx context.Context, brand string, startYear int, endYear int) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByBrandAndBetweenYears(brand, internal.ClosedInterval(float64(startYear), float64(endYear)))
+}
+
+func (l *local) FindAllByFuelType(ctx context.Context, fuelType string) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByFuelType(fuelType)
+}
+
+func (l *local) FindAllByTransmission(ctx context.Context, transmission string) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByTransmission(transmission)
+}
+
+func (l *local) FindAllByDimensions(ctx context.Context, height, width internal.Interval) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByDimensions(height, width)
+}
+
+func (l *local) FindAllByWeight(ctx context.Context, weight internal.Interval) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByWeight(weight)
+}
+
+func (l *local) FindAllByChargingConnector(ctx context.Context, connector string) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByChargingConnector(connector)
+}
+
+func (l *local) FindAllByRange(ctx context.Context, r internal.Interval) (v []internal.Vehicle, err error) {
+	return l.sv.FindAllByRange(r)
+}
+
+func (l *local) UpdateMaxSpeedById(ctx context.Context, id int, maxSpeed int) (uv internal.Vehicle, err error) {
+	if uv, err = l.sv.UpdateMaxSpeedById(id, maxSpeed); err != nil {
+		return
+	}
+	err = l.save()
+	return
+}
+
+func (l *local) UpdateFuelTypeById(ctx context.Context, id int, f internal.VehicleFuel) (uv internal.Vehicle, err error) {
+	if uv, err = l.sv.UpdateFuelTypeById(id, f); err != nil {
+		return
+	}
+	err = l.save()
+	return
+}
+
+func (l *local) Delete(ctx context.Context, id int) (err error) {
+	if err = l.sv.Delete(id); err != nil {
+		return
+	}
+	err = l.save()
+	return
+}
+
+func (l *local) CalculateAverageSpeedByBrand(ctx context.Context, brand string) (avg float64, err error) {
+	return l.sv.CalculateAverageSpeedByBrand(brand)
+}
+
+func (l *local) CalculateAverageCapacityByBrand(ctx context.Context, brand string) (avg float64, err error) {
+	return l.sv.CalculateAverageCapacityByBrand(brand)
+}
@@ -0,0 +1,487 @@
+package main
+
+import (
+	"app/internal"
+	"app/internal/loader"
+	"app/internal/repository"
+	"app/internal/service"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// errUsage is returned when a command is called with invalid flags or arguments.
+var errUsage = errors.New("invalid usage")
+
+// command is an struct that represents a subcommand of vehiclectl.
+type command struct {
+	// help is the description shown in the usage.
+	help string
+	// backend is whether the command works on the vehicles of -file or -server.
+	backend bool
+	// run executes the command with its arguments.
+	run func(ctx context.Context, b backend, args []string) (err error)
+}
+
+// commandNames are the names of the commands, in the order they are shown in the usage.
+var commandNames = []string{"list", "create", "update", "delete", "import", "export", "validate", "stats"}
+
+// commands are the subcommands of vehiclectl, by name.
+var commands = map[string]command{
+	"list":     {help: "list vehicles, optionally filtered", backend: true, run: runList},
+	"create":   {help: "create a vehicle", backend: true, run: runCreate},
+	"update":   {help: "update the max speed or fuel type of a vehicle", backend: true, run: runUpdate},
+	"delete":   {help: "delete vehicles by id", backend: true, run: runDelete},
+	"import":   {help: "create the vehicles of a data file", backend: true, run: runImport},
+	"export":   {help: "write all vehicles to a data file", backend: true, run: runExport},
+	"validate": {help: "check a data file against the validation rules, offline", run: runValidate},
+	"stats":    {help: "print the count, average max speed and average capacity by brand", backend: true, run: runStats},
+}
+
+// newHTTPClient returns the http client of the requests to the server.
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout}
+}
+
+// runList prints the vehicles matching at most one of the filters.
+// usage: list [-o table|json] [-color <c> -year <y> | -brand <b> -from <y> -to <y> | -fuel-type <t> | -transmission <t> |
+// -height <range> -width <range> | -weight <range> | -charging-connector <c> | -range <range>]
+// A range is written as min-max (both included), in brackets (e.g. [100,200)) or as a comparison (e.g. >=100).
+func runList(ctx context.Context, b backend, args []string) (err error) {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	output := fs.String("o", "table", "output format: table or json")
+	color := fs.String("color", "", "color, with -year")
+	year := fs.Int("year", 0, "fabrication year, with -color")
+	brand := fs.String("brand", "", "brand, with -from and -to")
+	from := fs.Int("from", 0, "start of the range of years (inclusive), with -brand")
+	to := fs.Int("to", 0, "end of the range of years (inclusive), with -brand")
+	fuelType := fs.String("fuel-type", "", "fuel type")
+	transmission := fs.String("transmission", "", "transmission")
+	height := fs.String("height", "", "range of heights (in cm unless they have a unit, e.g. 1.5m-2m or >=150)")
+	width := fs.String("width", "", "range of widths (in cm unless they have a unit, e.g. [150,200))")
+	weight := fs.String("weight", "", "range of weights (in kg unless they have a unit, e.g. 1t-3000lb or <2t)")
+	chargingConnector := fs.String("charging-connector", "", "charging connector")
+	rng := fs.String("range", "", "range of electric ranges in km (e.g. 300-500 or >=300)")
+	if err = fs.Parse(args); err != nil {
+		return
+	}
+
+	// the flags that were set choose the filter
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	delete(set, "o")
+
+	var vehicles []internal.Vehicle
+	switch {
+	case len(set) == 0:
+		vehicles, err = b.FindAll(ctx)
+	case equalKeys(set, "color", "year"):
+		vehicles, err = b.FindAllByColorAndYear(ctx, *color, *year)
+	case equalKeys(set, "brand", "from", "to"):
+		vehicles, err = b.FindAllByBrandAndBetweenYears(ctx, *brand, *from, *to)
+	case equalKeys(set, "fuel-type"):
+		vehicles, err = b.FindAllByFuelType(ctx, *fuelType)
+	case equalKeys(set, "transmission"):
+		vehicles, err = b.FindAllByTransmission(ctx, *transmission)
+	case equalKeys(set, "height", "width"), equalKeys(set, "height"), equalKeys(set, "width"):
+		// - a missing dimension is not bounded
+		var h, w internal.Interval
+		if set["height"] {
+			if h, err = parseInterval(*height, parseLength); err != nil {
+				return
+			}
+		}
+		if set["width"] {
+			if w, err = parseInterval(*width, parseLength); err != nil {
+				return
+			}
+		}
+		vehicles, err = b.FindAllByDimensions(ctx, h, w)
+	case equalKeys(set, "weight"):
+		var w internal.Interval
+		if w, err = parseInterval(*weight, parseWeight); err != nil {
+			return
+		}
+		vehicles, err = b.FindAllByWeight(ctx, w)
+	case equalKeys(set, "charging-connector"):
+		vehicles, err = b.FindAllByChargingConnector(ctx, *chargingConnector)
+	case equalKeys(set, "range"):
+		var r internal.Interval
+		if r, err = parseInterval(*rng, parseNumber); err != nil {
+			return
+		}
+		vehicles, err = b.FindAllByRange(ctx, r)
+	default:
+		err = fmt.Errorf("%w: the filters are -color and -year, -brand, -from and -to, -fuel-type, -transmission, -height and/or -width, -weight, -charging-connector or -range", errUsage)
+	}
+	if err != nil {
+		return
+	}
+
+	err = printVehicles(*output, vehicles)
+	return
+}
+
+// runCreate creates a vehicle with the attributes of the flags and prints it.
+func runCreate(ctx context.Context, b backend, args []string) (err error) {
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	output := fs.String("o", "table", "output format: table or json")
+	var a internal.VehicleAttributes
+	fs.StringVar(&a.Brand, "brand", "", "brand")
+	fs.StringVar(&a.Model, "model", "", "model")
+	fs.StringVar(&a.Registration, "registration", "", "registration")
+	fs.IntVar(&a.Year, "year", 0, "fabrication year")
+	fs.StringVar(&a.Color, "color", "", "color")
+	fs.IntVar(&a.MaxSpeed, "max-speed", 0, "max speed")
+	fs.StringVar(&a.FuelType, "fuel-type", "", "fuel type")
+	fs.StringVar(&a.Transmission, "transmission", "", "transmission")
+	fs.IntVar(&a.Passengers, "passengers", 0, "capacity of passengers")
+	fs.Func("height", "height, in cm unless it has a unit (e.g. 1.5m)", func(s string) (err error) {
+		a.Height, err = parseLength(s)
+		return
+	})
+	fs.Func("width", "width, in cm unless it has a unit (e.g. 70in)", func(s string) (err error) {
+		a.Width, err = parseLength(s)
+		return
+	})
+	fs.Func("weight", "weight, in kg unless it has a unit (e.g. 1.2t)", func(s string) (err error) {
+		a.Weight, err = parseWeight(s)
+		return
+	})
+	fs.Float64Var(&a.BatteryCapacity, "battery-capacity", 0, "capacity of the battery in kWh, for electric and hybrid vehicles")
+	fs.IntVar(&a.Range, "range", 0, "electric range in km, for electric and hybrid vehicles")
+	fs.StringVar(&a.ChargingConnector, "charging-connector", "", "charging connector, for electric and plug-in hybrid vehicles")
+	if err = fs.Parse(args); err != nil {
+		return
+	}
+
+	v, err := b.Insert(ctx, internal.Vehicle{Attributes: a})
+	if err != nil {
+		return
+	}
+	err = printVehicles(*output, []internal.Vehicle{v})
+	return
+}
+
+// runUpdate updates the max speed and/or fuel type of a vehicle and prints it.
+// The energy attributes replace the ones of the vehicle with the fuel type.
+// usage: update [-max-speed <n>] [-fuel-type <t> [-battery-capacity <n>] [-range <n>] [-charging-connector <c>]] <id>
+func runUpdate(ctx context.Context, b backend, args []string) (err error) {
+	fs := flag.NewFlagSet("update", flag.ContinueOnError)
+	output := fs.String("o", "table", "output format: table or json")
+	maxSpeed := fs.Int("max-speed", 0, "new max speed")
+	var f internal.VehicleFuel
+	fs.StringVar(&f.FuelType, "fuel-type", "", "new fuel type")
+	fs.Float64Var(&f.BatteryCapacity, "battery-capacity", 0, "capacity of the battery in kWh, with the fuel type of electric and hybrid vehicles")
+	fs.IntVar(&f.Range, "range", 0, "electric range in km, with the fuel type of electric and hybrid vehicles")
+	fs.StringVar(&f.ChargingConnector, "charging-connector", "", "charging connector, with the fuel type of electric and plug-in hybrid vehicles")
+	if err = fs.Parse(args); err != nil {
+		return
+	}
+	if fs.NArg() != 1 {
+		err = fmt.Errorf("%w: usage: update [-max-speed <n>] [-fuel-type <t> [-battery-capacity <n>] [-range <n>] [-charging-connector <c>]] <id>", errUsage)
+		return
+	}
+	id, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		err = fmt.Errorf("%w: invalid id %q", errUsage, fs.Arg(0))
+		return
+	}
+
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if !set["max-speed"] && !set["fuel-type"] {
+		err = fmt.Errorf("%w: -max-speed or -fuel-type is required", errUsage)
+		return
+	}
+	if !set["fuel-type"] && (set["battery-capacity"] || set["range"] || set["charging-connector"]) {
+		err = fmt.Errorf("%w: -battery-capacity, -range and -charging-connector are set with -fuel-type", errUsage)
+		return
+	}
+
+	var v internal.Vehicle
+	if set["max-speed"] {
+		if v, err = b.UpdateMaxSpeedById(ctx, id, *maxSpeed); err != nil {
+			return
+		}
+	}
+	if set["fuel-type"] {
+		if v, err = b.UpdateFuelTypeById(ctx, id, f); err != nil {
+			return
+		}
+	}
+	err = printVehicles(*output, []internal.Vehicle{v})
+	return
+}
+
+// runDelete deletes the vehicles with the ids of the arguments.
+// usage: delete <id> [<id> ...]
+func runDelete(ctx context.Context, b backend, args []string) (err error) {
+	if len(args) == 0 {
+		err = fmt.Errorf("%w: usage: delete <id> [<id> ...]", errUsage)
+		return
+	}
+
+	ids := make([]int, len(args))
+	for i, arg := range args {
+		if ids[i], err = strconv.Atoi(arg); err != nil {
+			err = fmt.Errorf("%w: invalid id %q", errUsage, arg)
+			return
+		}
+	}
+	for _, id := range ids {
+		if err = b.Delete(ctx, id); err != nil {
+			err = fmt.Errorf("vehicle %d: %w", id, err)
+			return
+		}
+		fmt.Printf("deleted vehicle %d\n", id)
+	}
+	return
+}
+
+// runImport creates the vehicles of a data file (new ids are assigned), all of them or none.
+// usage: import <file>
+func runImport(ctx context.Context, b backend, args []string) (err error) {
+	if len(args) != 1 {
+		err = fmt.Errorf("%w: usage: import <file>", errUsage)
+		return
+	}
+
+	data, err := loader.NewVehicleJSON(args[0]).Load()
+	if err != nil {
+		return
+	}
+	vehicles := make([]internal.Vehicle, len(data.Data))
+	for i, v := range data.Data {
+		vehicles[i] = internal.Vehicle{Attributes: v.Attributes}
+	}
+
+	nvs, err := b.InsertMany(ctx, vehicles)
+	if err != nil {
+		return
+	}
+	fmt.Printf("imported %d vehicles\n", len(nvs))
+	return
+}
+
+// runExport writes all vehicles to a data file, compressed according to its extension (.gz or .zst).
+// usage: export <file>
+func runExport(ctx context.Context, b backend, args []string) (err error) {
+	if len(args) != 1 {
+		err = fmt.Errorf("%w: usage: export <file>", errUsage)
+		return
+	}
+
+	vehicles, err := b.FindAll(ctx)
+	if err != nil {
+		return
+	}
+	data := internal.LoadData{Data: vehicles}
+	for _, v := range vehicles {
+		if v.ID > data.LastId {
+			data.LastId = v.ID
+		}
+	}
+
+	if err = loader.NewVehicleJSON(args[0]).Save(data); err != nil {
+		return
+	}
+	fmt.Printf("exported %d vehicles\n", len(vehicles))
+	return
+}
+
+// runValidate checks every vehicle of a data file with the validation rules of the vehicle service,
+// and that ids are not repeated nor greater than the last id. It does not need -file or -server.
+// usage: validate <file>
+func runValidate(ctx context.Context, _ backend, args []string) (err error) {
+	if len(args) != 1 {
+		err = fmt.Errorf("%w: usage: validate <file>", errUsage)
+		return
+	}
+
+	data, err := loader.NewVehicleJSON(args[0]).Load()
+	if err != nil {
+		return
+	}
+
+	// each vehicle is inserted in an empty service, so only its attributes are checked
+	sv := service.NewDefault(repository.NewVehicleSlice(nil, 0), nil, nil, nil)
+	ids := make(map[int]bool)
+	invalid := 0
+	for i, v := range data.Data {
+		var problems []string
+		if _, e := sv.Insert(internal.Vehicle{Attributes: v.Attributes}); e != nil {
+			problems = append(problems, e.Error())
+		}
+		if ids[v.ID] {
+			problems = append(problems, "repeated id")
+		}
+		ids[v.ID] = true
+		if v.ID > data.LastId {
+			problems = append(problems, fmt.Sprintf("id greater than last_id %d", data.LastId))
+		}
+
+		if len(problems) > 0 {
+			invalid++
+			fmt.Printf("vehicle #%d (id %d): %s\n", i, v.ID, strings.Join(problems, "; "))
+		}
+	}
+
+	if invalid > 0 {
+		err = fmt.Errorf("%d of %d vehicles are invalid", invalid, len(data.Data))
+		return
+	}
+	fmt.Printf("%d vehicles are valid\n", len(data.Data))
+	return
+}
+
+// runStats prints, for each brand, the number of vehicles and their average max speed and capacity.
+// usage: stats [-o table|json] [-brand <b>]
+func runStats(ctx context.Context, b backend, args []string) (err error) {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	output := fs.String("o", "table", "output format: table or json")
+	brand := fs.String("brand", "", "only the stats of the brand")
+	if err = fs.Parse(args); err != nil {
+		return
+	}
+
+	vehicles, err := b.FindAll(ctx)
+	if err != nil {
+		return
+	}
+	counts := make(map[string]int)
+	for _, v := range vehicles {
+		if *brand == "" || v.Attributes.Brand == *brand {
+			counts[v.Attributes.Brand]++
+		}
+	}
+	if len(counts) == 0 {
+		err = internal.ErrServiceVehiclesNotFound
+		return
+	}
+
+	type brandStats struct {
+		Brand           string  `json:"brand"`
+		Count           int     `json:"count"`
+		AverageMaxSpeed float64 `json:"average_max_speed"`
+		AverageCapacity float64 `json:"average_capacity"`
+	}
+	stats := make([]brandStats, 0, len(counts))
+	for name, count := range counts {
+		s := brandStats{Brand: name, Count: count}
+		if s.AverageMaxSpeed, err = b.CalculateAverageSpeedByBrand(ctx, name); err != nil {
+			return
+		}
+		if s.AverageCapacity, err = b.CalculateAverageCapacityByBrand(ctx, name); err != nil {
+			return
+		}
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Brand < stats[j].Brand })
+
+	switch *output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(stats)
+	case "table":
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "BRAND\tCOUNT\tAVG MAX SPEED\tAVG CAPACITY")
+		for _, s := range stats {
+			fmt.Fprintf(tw, "%s\t%d\t%.2f\t%.2f\n", s.Brand, s.Count, s.AverageMaxSpeed, s.AverageCapacity)
+		}
+		err = tw.Flush()
+	default:
+		err = fmt.Errorf("%w: invalid output format %q", errUsage, *output)
+	}
+	return
+}
+
+// printVehicles writes the vehicles to the standard output as a table or as json.
+func printVehicles(output string, vehicles []internal.Vehicle) (err error) {
+	switch output {
+	case "json":
+		data := make([]loader.VehicleDataJSON, len(vehicles))
+		for i, v := range vehicles {
+			data[i] = loader.VehicleDataJSON{
+				ID:                v.ID,
+				Brand:             v.Attributes.Brand,
+				Model:             v.Attributes.Model,
+				Registration:      v.Attributes.Registration,
+				Year:              v.Attributes.Year,
+				Color:             v.Attributes.Color,
+				MaxSpeed:          v.Attributes.MaxSpeed,
+				FuelType:          v.Attributes.FuelType,
+				Transmission:      v.Attributes.Transmission,
+				Passengers:        v.Attributes.Passengers,
+				Height:            v.Attributes.Height,
+				Width:             v.Attributes.Width,
+				Weight:            v.Attributes.Weight,
+				BatteryCapacity:   v.Attributes.BatteryCapacity,
+				Range:             v.Attributes.Range,
+				ChargingConnector: v.Attributes.ChargingConnector,
+			}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(data)
+	case "table":
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tBRAND\tMODEL\tREGISTRATION\tYEAR\tCOLOR\tMAX SPEED\tFUEL TYPE\tTRANSMISSION\tPASSENGERS\tHEIGHT (CM)\tWIDTH (CM)\tWEIGHT (KG)\tBATTERY (KWH)\tRANGE (KM)\tCONNECTOR")
+		for _, v := range vehicles {
+			a := v.Attributes
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%d\t%s\t%d\t%s\t%s\t%d\t%g\t%g\t%g\t%g\t%d\t%s\n",
+				v.ID, a.Brand, a.Model, a.Registration, a.Year, a.Color, a.MaxSpeed, a.FuelType, a.Transmission, a.Passengers, a.Height, a.Width, a.Weight,
+				a.BatteryCapacity, a.Range, a.ChargingConnector)
+		}
+		err = tw.Flush()
+	default:
+		err = fmt.Errorf("%w: invalid output format %q", errUsage, output)
+	}
+	return
+}
+
+// equalKeys returns whether the set has exactly the given keys.
+func equalKeys(set map[string]bool, keys ...string) bool {
+	if len(set) != len(keys) {
+		return false
+	}
+	for _, k := range keys {
+		if !set[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseInterval returns the range written in s as in internal.ParseInterval, each of its bounds parsed with parse.
+func parseInterval(s string, parse func(s string) (float64, error)) (in internal.Interval, err error) {
+	if in, err = internal.ParseInterval(s, parse); err != nil {
+		err = fmt.Errorf("%w: invalid range %q, it must be min-max, in brackets or a comparison", errUsage, s)
+	}
+	return
+}
+
+// parseNumber returns the number written in s.
+func parseNumber(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// parseLength returns the length written in s in the unit of the vehicles, which is the unit of the lengths without one.
+func parseLength(s string) (float64, error) {
+	return internal.ParseLength(s, internal.VehicleLengthUnit, internal.VehicleLengthUnit)
+}
+
+// parseWeight returns the weight written in s in the unit of the vehicles, which is the unit of the weights without one.
+func parseWeight(s string) (float64, error) {
+	return internal.ParseWeight(s, internal.VehicleWeightUnit, internal.VehicleWeightUnit)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"app/client"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// vehiclectl manages vehicles, either in a data file or through a running server.
+// usage: go run ./cmd/vehiclectl (-file <file> | -server <url>) <command> [flags] [args]
+func main() {
+	fs := flag.NewFlagSet("vehiclectl", flag.ExitOnError)
+	file := fs.String("file", "", "data file to work on")
+	server := fs.String("server", os.Getenv("VEHICLECTL_SERVER"), "address of the server to work on (e.g. http://localhost:8080)")
+	timeout := fs.Duration("timeout", 30*time.Second, "timeout of each request to the server")
+	fs.Usage = usage(fs)
+	fs.Parse(os.Args[1:])
+
+	// command
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	name, args := fs.Arg(0), fs.Args()[1:]
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "vehiclectl: unknown command %q\n", name)
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// backend
+	// - validate only reads the file given as argument
+	var b backend
+	if cmd.backend {
+		switch {
+		case *file != "" && *server != "":
+			fmt.Fprintln(os.Stderr, "vehiclectl: -file and -server can not be used together")
+			os.Exit(2)
+		case *file != "":
+			l, err := newLocal(*file)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "vehiclectl: %v\n", err)
+				os.Exit(1)
+			}
+			b = l
+		case *server != "":
+			b = client.NewDefault(&client.ConfigDefault{BaseURL: *server, Client: newHTTPClient(*timeout)})
+		default:
+			fmt.Fprintln(os.Stderr, "vehiclectl: either -file or -server is required")
+			os.Exit(2)
+		}
+	}
+
+	// run
+	if err := cmd.run(ctx, b, args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "vehiclectl %s: %v\n", name, err)
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+// usage returns the function that prints the usage of vehiclectl.
+func usage(fs *flag.FlagSet) func() {
+	return func() {
+		fmt.Fprintln(os.Stderr, "usage: vehiclectl (-file <file> | -server <url>) <command> [flags] [args]")
+		fmt.Fprintln(os.Stderr, "\ncommands:")
+		for _, name := range commandNames {
+			fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].help)
+		}
+		fmt.Fprintln(os.Stderr, "\nflags:")
+		fs.PrintDefaults()
+	}
+}
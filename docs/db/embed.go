@@ -0,0 +1,12 @@
+// Package db contains the sample datasets of vehicles.
+package db
+
+import "embed"
+
+// Default is the name of the dataset used when no file is configured.
+const Default = "vehicles_100.json"
+
+// FS contains the default dataset so it can be compiled into a binary.
+//
+//go:embed vehicles_100.json
+var FS embed.FS
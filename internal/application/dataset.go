@@ -0,0 +1,10 @@
+//go:build !embed
+
+package application
+
+import "app/internal"
+
+// defaultDataset returns nil, the binary was built without a dataset (see -tags embed).
+func defaultDataset() internal.Loader {
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:build embed
+
+package application
+
+import (
+	"app/docs/db"
+	"app/internal"
+	"app/internal/loader"
+)
+
+// defaultDataset returns the loader of the dataset compiled into the binary (built with -tags embed).
+func defaultDataset() internal.Loader {
+	return loader.NewVehicleJSONFS(db.FS, db.Default)
+}
@@ -1,100 +1,180 @@
 package application
 
 import (
+	"app/internal"
 	"app/internal/handler"
 	"app/internal/loader"
-	"app/internal/repository"
-	"app/internal/service"
+	"app/internal/openapi"
+	"log"
+	"net"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 // ConfigDefaultInMemory is an struct that contains the configuration for the default application settings.
 type ConfigDefaultInMemory struct {
-	// FileLoader is the path to the file that contains the vehicles.
+	// FileLoader is the path (or glob pattern) to the file that contains the vehicles.
 	FileLoader string
+	// FileLoaders are extra paths (or glob patterns) to files with vehicles, merged with FileLoader.
+	FileLoaders []string
+	// ConflictPolicy is how vehicles repeated across files are resolved (error, first, last or renumber).
+	ConflictPolicy string
 	// Addr is the address where the application will be listening.
 	Addr string
+	// GRPCAddr is the address where the grpc server will be listening.
+	GRPCAddr string
+	// MaintenanceIntervals are the intervals the maintenance of the vehicles is due at, the default ones if nil.
+	MaintenanceIntervals []internal.MaintenanceInterval
 }
 
 // NewDefaultInMemory returns a new instance of a default application.
 func NewDefaultInMemory(c *ConfigDefaultInMemory) *DefaultInMemory {
 	// default config
 	defaultCfg := &ConfigDefaultInMemory{
-		FileLoader: "vehicles.json",
-		Addr:       ":8080",
+		FileLoader:           "vehicles.json",
+		Addr:                 ":8080",
+		GRPCAddr:             ":9090",
+		MaintenanceIntervals: internal.DefaultMaintenanceIntervals(),
 	}
 	if c != nil {
 		if c.FileLoader != "" {
 			defaultCfg.FileLoader = c.FileLoader
 		}
+		if len(c.FileLoaders) > 0 {
+			defaultCfg.FileLoaders = c.FileLoaders
+			// the default file is only used when no file is given
+			if c.FileLoader == "" {
+				defaultCfg.FileLoader = ""
+			}
+		}
+		if c.ConflictPolicy != "" {
+			defaultCfg.ConflictPolicy = c.ConflictPolicy
+		}
 		if c.Addr != "" {
 			defaultCfg.Addr = c.Addr
 		}
+		if c.GRPCAddr != "" {
+			defaultCfg.GRPCAddr = c.GRPCAddr
+		}
+		if c.MaintenanceIntervals != nil {
+			defaultCfg.MaintenanceIntervals = c.MaintenanceIntervals
+		}
+	}
+
+	// without files, a binary built with a dataset compiled in starts with it
+	var dataset internal.Loader
+	if c == nil || (c.FileLoader == "" && len(c.FileLoaders) == 0) {
+		dataset = defaultDataset()
 	}
 
 	return &DefaultInMemory{
-		fileLoader: defaultCfg.FileLoader,
-		addr:       defaultCfg.Addr,
+		dataset:        dataset,
+		fileLoaders:    append([]string{defaultCfg.FileLoader}, defaultCfg.FileLoaders...),
+		conflictPolicy: defaultCfg.ConflictPolicy,
+		addr:           defaultCfg.Addr,
+		grpcAddr:       defaultCfg.GRPCAddr,
+		maintenance:    defaultCfg.MaintenanceIntervals,
 	}
 }
 
 // DefaultInMemory is an struct that contains the default application settings.
 type DefaultInMemory struct {
-	// fileLoader is the path to the file that contains the vehicles.
-	fileLoader string
+	// dataset is the loader of the dataset compiled into the binary, used instead of the files if not nil.
+	dataset internal.Loader
+	// fileLoaders are the paths (or glob patterns) to the files that contain the vehicles.
+	fileLoaders []string
+	// conflictPolicy is how vehicles repeated across files are resolved.
+	conflictPolicy string
 	// addr is the address where the application will be listening.
 	addr string
+	// grpcAddr is the address where the grpc server will be listening.
+	grpcAddr string
+	// maintenance are the intervals the maintenance of the vehicles is due at.
+	maintenance []internal.MaintenanceInterval
 }
 
 // Run starts the application.
 func (d *DefaultInMemory) Run() (err error) {
 	// dependencies initialization
 	// loader
-	ld := loader.NewVehicleJSON(d.fileLoader)
+	ld, err := d.loader()
+	if err != nil {
+		return
+	}
 	data, err := ld.Load()
 	if err != nil {
 		return
 	}
 
-	// repository
-	rp := repository.NewVehicleSlice(data.Data, data.LastId)
-
-	// service
-	sv := service.NewDefault(rp)
-
-	// handler
-	hd := handler.NewVehicleDefault(sv)
+	// services
+	// - the listeners of the services run while the application runs
+	s, stop, err := NewServices(data, d.maintenance)
+	if err != nil {
+		return
+	}
+	defer stop()
 
 	// router
-	rt := gin.New()
-	// - middlewares
-	rt.Use(gin.Logger())
-	rt.Use(gin.Recovery())
-	// - endpoints
-	gr := rt.Group("/vehicles")
-	{
-		gr.GET("", hd.GetAll())
-		gr.POST("", hd.Create())
-		gr.GET("/color/:color/year/:year", hd.GetAllByColorAndYear())
-		gr.GET("/brand/:brand/between/:start_year/:end_year", hd.GetAllByBrandAndBetweenYears())
-		gr.GET("/average_speed/brand/:brand", hd.CalculateAverageSpeedByBrand())
-		gr.POST("/batch", hd.CreateMany())
-		gr.PUT("/:id/update_speed", hd.UpdateMaxSpeedById())
-		gr.GET("/fuel_type/:type", hd.GetAllByFuelType())
-		gr.DELETE("/:id", hd.Delete())
-		gr.GET("/transmission/:type", hd.GetAllByTransmission())
-		gr.PUT("/:id/update_fuel", hd.UpdateFuelTypeById())
-		gr.GET("/average_capacity/brand/:brand", hd.CalculateAverageCapacityByBrand())
-		gr.GET("/dimensions", hd.GetAllByDimensions())
-		gr.GET("/weight", hd.GetAllByWeights())
+	rt, err := NewRouter(s, gin.Logger(), gin.Recovery())
+	if err != nil {
+		return
+	}
+	// - the routes not documented are only warned about, the tests are the ones requiring all of them
+	if missing, err := openapi.Missing(rt.Routes()); err != nil {
+		log.Printf("application: checking the openapi document: %v", err)
+	} else if len(missing) > 0 {
+		log.Printf("application: routes missing from the openapi document: %v", missing)
 	}
 
+	// grpc server
+	// - shares the service with the http server
+	gs := grpc.NewServer()
+	handler.NewVehicleGRPC(s.Vehicle).Register(gs)
+	lis, err := net.Listen("tcp", d.grpcAddr)
+	if err != nil {
+		return
+	}
+	defer gs.Stop()
+
 	// run application
-	err = rt.Run(d.addr)
+	// - until either server fails
+	errs := make(chan error, 2)
+	go func() { errs <- gs.Serve(lis) }()
+	go func() { errs <- rt.Run(d.addr) }()
+	err = <-errs
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// loader returns the loader for the configured files, merging them when there is more than one.
+// The conflict policy is validated even if it is not needed, so a wrong one is not noticed only once files are added.
+func (d *DefaultInMemory) loader() (ld internal.Loader, err error) {
+	policy, err := loader.ParseConflictPolicy(d.conflictPolicy)
 	if err != nil {
 		return
 	}
+	if d.dataset != nil {
+		ld = d.dataset
+		return
+	}
 
+	paths, err := loader.ExpandPaths(d.fileLoaders...)
+	if err != nil {
+		return
+	}
+	if len(paths) == 1 {
+		ld = loader.NewVehicleJSON(paths[0])
+		return
+	}
+
+	loaders := make([]internal.Loader, len(paths))
+	for i, path := range paths {
+		loaders[i] = loader.NewVehicleJSON(path)
+	}
+	ld = loader.NewVehicleComposite(policy, loaders...)
 	return
 }
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"app/internal"
+	"app/internal/loader"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultInMemory_Loader(t *testing.T) {
+	dir := t.TempDir()
+	one, two := filepath.Join(dir, "one.json"), filepath.Join(dir, "two.json")
+	tests := []struct {
+		name      string
+		cfg       ConfigDefaultInMemory
+		err       error
+		composite bool
+	}{
+		{"one file", ConfigDefaultInMemory{FileLoader: one}, nil, false},
+		{"one file with a policy", ConfigDefaultInMemory{FileLoader: one, ConflictPolicy: "last"}, nil, false},
+		{"one file with an invalid policy", ConfigDefaultInMemory{FileLoader: one, ConflictPolicy: "newest"}, internal.ErrLoaderInvalidConflictPolicy, false},
+		{"files", ConfigDefaultInMemory{FileLoader: one, FileLoaders: []string{two}, ConflictPolicy: "renumber"}, nil, true},
+		{"files with an invalid policy", ConfigDefaultInMemory{FileLoader: one, FileLoaders: []string{two}, ConflictPolicy: "newest"}, internal.ErrLoaderInvalidConflictPolicy, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld, err := NewDefaultInMemory(&tt.cfg).loader()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if _, ok := ld.(*loader.VehicleComposite); ok != tt.composite {
+				t.Errorf("loader = %T, composite %t", ld, tt.composite)
+			}
+		})
+	}
+}
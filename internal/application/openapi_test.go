@@ -0,0 +1,54 @@
+package application
+
+import (
+	"app/internal"
+	"app/internal/openapi"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newTestRouter returns the router of the application over the vehicles, without middlewares.
+func newTestRouter(t *testing.T, vehicles ...internal.Vehicle) (rt *gin.Engine, s *Services) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	s, stop, err := NewServices(internal.LoadData{Data: vehicles, LastId: len(vehicles)}, internal.DefaultMaintenanceIntervals())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stop)
+	rt, err = NewRouter(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestOpenAPI_RoutesDocumented(t *testing.T) {
+	rt, _ := newTestRouter(t)
+
+	routes := rt.Routes()
+	if len(routes) == 0 {
+		t.Fatal("no routes registered")
+	}
+	missing, err := openapi.Missing(routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) > 0 {
+		t.Errorf("routes missing from the openapi document: %v", missing)
+	}
+}
+
+func TestOpenAPI_MissingRoute(t *testing.T) {
+	rt, _ := newTestRouter(t)
+	rt.GET("/undocumented", func(ctx *gin.Context) {})
+
+	missing, err := openapi.Missing(rt.Routes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0] != "GET /undocumented" {
+		t.Errorf("missing = %v, want [GET /undocumented]", missing)
+	}
+}
@@ -0,0 +1,198 @@
+package application
+
+import (
+	"app/internal"
+	"app/internal/eventbus"
+	"app/internal/handler"
+	"app/internal/openapi"
+	"app/internal/repository"
+	"app/internal/service"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Services is an struct that contains the services of the application, shared by the http and grpc servers.
+type Services struct {
+	// Vehicle is the service of the vehicles.
+	Vehicle internal.ServiceVehicle
+	// Events is the bus the changes of the vehicles are published to.
+	Events internal.EventBusVehicle
+	// Reference is the service of the vocabularies.
+	Reference internal.ServiceReference
+	// Owner is the service of the owners.
+	Owner internal.ServiceOwner
+	// Fleet is the service of the fleets.
+	Fleet internal.ServiceFleet
+	// Maintenance is the service of the maintenance records.
+	Maintenance internal.ServiceMaintenance
+	// Webhook is the service of the webhooks.
+	Webhook internal.ServiceWebhook
+}
+
+// NewServices returns the services of the application, in memory and starting with the loaded vehicles.
+// The services listening to the events of the vehicles do so until stop is called.
+func NewServices(data internal.LoadData, intervals []internal.MaintenanceInterval) (s *Services, stop func(), err error) {
+	// repository
+	rp := repository.NewVehicleSlice(data.Data, data.LastId)
+
+	// event bus
+	// - keeps the last events so subscribers can resume
+	bus := eventbus.NewVehicleMemory(1024)
+
+	// service
+	// - the vocabularies start with the default values, more can be added while the application runs
+	sr := service.NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	// - vehicles can belong to owners, who cannot be deleted while they have vehicles
+	// - owners are only kept in memory, the vehicles are loaded and saved without them
+	ro := repository.NewOwnerMap()
+	sv := service.NewDefault(rp, bus, sr, ro)
+	so := service.NewOwnerDefault(ro, rp)
+	// - fleets group vehicles, their list and aggregate routes see only the vehicles of a fleet
+	// - deleted vehicles are removed from their fleets as their events are published
+	sf := service.NewFleetDefault(repository.NewFleetMap(), rp, func(ids []int) internal.ServiceVehicle {
+		return service.NewDefault(repository.NewVehicleScope(rp, ids), bus, sr, ro)
+	})
+	// - the intervals are validated as the ones replaced while the application runs
+	// - the records of deleted vehicles are removed as their events are published
+	sm := service.NewMaintenanceDefault(repository.NewMaintenanceMap(nil), rp, sr)
+	if _, err = sm.ReplaceIntervals(intervals); err != nil {
+		err = fmt.Errorf("application: %w", err)
+		return
+	}
+	// - webhooks are sent the events of the bus
+	sw := service.NewWebhookDefault(repository.NewWebhookMap(), nil)
+
+	stopFleets := sf.Listen(bus)
+	stopMaintenance := sm.Listen(bus)
+	stopWebhooks := sw.Listen(bus)
+	stop = func() {
+		stopFleets()
+		stopMaintenance()
+		stopWebhooks()
+	}
+
+	s = &Services{
+		Vehicle:     sv,
+		Events:      bus,
+		Reference:   sr,
+		Owner:       so,
+		Fleet:       sf,
+		Maintenance: sm,
+		Webhook:     sw,
+	}
+	return
+}
+
+// NewRouter returns the router of the http server with the routes of the services, after the middlewares.
+func NewRouter(s *Services, middlewares ...gin.HandlerFunc) (rt *gin.Engine, err error) {
+	// handler
+	hd := handler.NewVehicleDefault(s.Vehicle, nil, nil)
+	he := handler.NewVehicleEventDefault(s.Events)
+	hw := handler.NewWebhookDefault(s.Webhook)
+	hr := handler.NewReferenceDefault(s.Reference)
+	hn := handler.NewOwnerDefault(s.Owner)
+	hf := handler.NewFleetDefault(s.Fleet, nil, nil)
+	hm := handler.NewMaintenanceDefault(s.Maintenance, nil)
+	ho := handler.NewOpenAPIDefault(openapi.Document)
+	hg, err := handler.NewGraphQLDefault(s.Vehicle)
+	if err != nil {
+		return
+	}
+
+	// router
+	rt = gin.New()
+	// - middlewares
+	rt.Use(middlewares...)
+	// - endpoints
+	gr := rt.Group("/vehicles")
+	{
+		gr.GET("", hd.GetAll())
+		gr.POST("", hd.Create())
+		gr.GET("/export", hd.Export())
+		gr.GET("/events", he.Stream())
+		gr.GET("/color/:color/year/:year", hd.GetAllByColorAndYear())
+		gr.GET("/brand/:brand/between/:start_year/:end_year", hd.GetAllByBrandAndBetweenYears())
+		gr.GET("/average_speed/brand/:brand", hd.CalculateAverageSpeedByBrand())
+		gr.POST("/batch", hd.CreateMany())
+		gr.PUT("/:id/update_speed", hd.UpdateMaxSpeedById())
+		gr.GET("/fuel_type/:type", hd.GetAllByFuelType())
+		gr.DELETE("/:id", hd.Delete())
+		gr.GET("/transmission/:type", hd.GetAllByTransmission())
+		gr.PUT("/:id/update_fuel", hd.UpdateFuelTypeById())
+		gr.PUT("/:id/owner", hd.UpdateOwnerById())
+		gr.GET("/average_capacity/brand/:brand", hd.CalculateAverageCapacityByBrand())
+		gr.GET("/dimensions", hd.GetAllByDimensions())
+		gr.GET("/weight", hd.GetAllByWeights())
+		gr.GET("/charging_connector/:connector", hd.GetAllByChargingConnector())
+		gr.GET("/range", hd.GetAllByRange())
+		gr.GET("/stats", hd.GetStats())
+		gr.GET("/histogram", hd.GetHistogram())
+		gr.GET("/search", hd.Search())
+		gr.GET("/:id/similar", hd.GetAllSimilar())
+		gr.GET("/maintenance/due", hm.GetDue())
+		gr.GET("/maintenance/intervals", hm.GetIntervals())
+		gr.PUT("/maintenance/intervals", hm.UpdateIntervals())
+		gr.GET("/:id/maintenance", hm.GetAll())
+		gr.POST("/:id/maintenance", hm.Create())
+		gr.GET("/:id/maintenance/:record_id", hm.Get())
+		gr.PUT("/:id/maintenance/:record_id", hm.Update())
+		gr.DELETE("/:id/maintenance/:record_id", hm.Delete())
+	}
+	grw := rt.Group("/webhooks")
+	{
+		grw.GET("", hw.GetAll())
+		grw.POST("", hw.Create())
+		grw.GET("/:id", hw.Get())
+		grw.PUT("/:id", hw.Update())
+		grw.DELETE("/:id", hw.Delete())
+		grw.GET("/:id/deliveries", hw.GetDeliveries())
+		grw.GET("/:id/dead_letters", hw.GetDeadLetters())
+		grw.POST("/:id/dead_letters/:dead_letter_id/retry", hw.RetryDeadLetter())
+	}
+	gro := rt.Group("/owners")
+	{
+		gro.GET("", hn.GetAll())
+		gro.POST("", hn.Create())
+		gro.GET("/:id", hn.Get())
+		gro.PUT("/:id", hn.Update())
+		gro.DELETE("/:id", hn.Delete())
+		gro.GET("/:id/vehicles", hn.GetVehicles())
+	}
+	grf := rt.Group("/fleets")
+	{
+		grf.GET("", hf.GetAll())
+		grf.POST("", hf.Create())
+		grf.GET("/:id", hf.Get())
+		grf.PUT("/:id", hf.Update())
+		grf.DELETE("/:id", hf.Delete())
+		grf.POST("/:id/vehicles", hf.AddVehicles())
+		grf.DELETE("/:id/vehicles/:vehicle_id", hf.RemoveVehicle())
+		// - the same handlers as the vehicle routes, within the fleet
+		grf.GET("/:id/vehicles", hf.Vehicles((*handler.VehicleDefault).GetAll))
+		grf.GET("/:id/vehicles/color/:color/year/:year", hf.Vehicles((*handler.VehicleDefault).GetAllByColorAndYear))
+		grf.GET("/:id/vehicles/brand/:brand/between/:start_year/:end_year", hf.Vehicles((*handler.VehicleDefault).GetAllByBrandAndBetweenYears))
+		grf.GET("/:id/vehicles/average_speed/brand/:brand", hf.Vehicles((*handler.VehicleDefault).CalculateAverageSpeedByBrand))
+		grf.GET("/:id/vehicles/fuel_type/:type", hf.Vehicles((*handler.VehicleDefault).GetAllByFuelType))
+		grf.GET("/:id/vehicles/transmission/:type", hf.Vehicles((*handler.VehicleDefault).GetAllByTransmission))
+		grf.GET("/:id/vehicles/average_capacity/brand/:brand", hf.Vehicles((*handler.VehicleDefault).CalculateAverageCapacityByBrand))
+		grf.GET("/:id/vehicles/dimensions", hf.Vehicles((*handler.VehicleDefault).GetAllByDimensions))
+		grf.GET("/:id/vehicles/weight", hf.Vehicles((*handler.VehicleDefault).GetAllByWeights))
+		grf.GET("/:id/vehicles/charging_connector/:connector", hf.Vehicles((*handler.VehicleDefault).GetAllByChargingConnector))
+		grf.GET("/:id/vehicles/range", hf.Vehicles((*handler.VehicleDefault).GetAllByRange))
+		grf.GET("/:id/vehicles/stats", hf.Vehicles((*handler.VehicleDefault).GetStats))
+		grf.GET("/:id/vehicles/histogram", hf.Vehicles((*handler.VehicleDefault).GetHistogram))
+	}
+	grr := rt.Group("/reference")
+	{
+		grr.GET("/:kind", hr.GetAll())
+		grr.POST("/:kind", hr.Create())
+	}
+	rt.GET("/graphql", hg.Query())
+	rt.POST("/graphql", hg.Query())
+
+	// - documentation
+	rt.GET("/openapi.json", ho.Document())
+	rt.GET("/docs", ho.UI())
+	return
+}
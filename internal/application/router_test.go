@@ -0,0 +1,156 @@
+package application
+
+import (
+	"app/internal"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// testVehicles are two Ford vehicles and a Nissan one.
+var testVehicles = []internal.Vehicle{
+	{ID: 1, Attributes: internal.VehicleAttributes{
+		Brand: "Ford", Model: "Focus", Registration: "AAA111", Year: 2015, Color: "Blue", MaxSpeed: 200,
+		FuelType: "gasoline", Transmission: "manual", Passengers: 5, Height: 150, Width: 180, Weight: 1300,
+	}},
+	{ID: 2, Attributes: internal.VehicleAttributes{
+		Brand: "Ford", Model: "Mustang", Registration: "BBB222", Year: 2020, Color: "Red", MaxSpeed: 250,
+		FuelType: "gasoline", Transmission: "automatic", Passengers: 4, Height: 140, Width: 190, Weight: 1700,
+	}},
+	{ID: 3, Attributes: internal.VehicleAttributes{
+		Brand: "Nissan", Model: "Leaf", Registration: "CCC333", Year: 2021, Color: "White", MaxSpeed: 150,
+		FuelType: "electric", Transmission: "automatic", Passengers: 5, Height: 155, Width: 177, Weight: 1500,
+		BatteryCapacity: 40, Range: 270, ChargingConnector: "CHAdeMO",
+	}},
+}
+
+func TestRouter_Fleets(t *testing.T) {
+	rt, _ := newTestRouter(t, testVehicles...)
+
+	// the steps run in order on the same fleet
+	steps := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		code     int
+		ids      []int
+		contains string
+	}{
+		{"create", http.MethodPost, "/fleets", `{"name": "Fords"}`, http.StatusCreated, nil, `"name":"Fords"`},
+		{"add vehicles", http.MethodPost, "/fleets/1/vehicles", `{"vehicle_ids": [2, 1]}`, http.StatusOK, []int{1, 2}, ""},
+		{"add a vehicle not found", http.MethodPost, "/fleets/1/vehicles", `{"vehicle_ids": [3, 9]}`, http.StatusBadRequest, nil, "invalid fleet vehicles"},
+		{"add without vehicles", http.MethodPost, "/fleets/1/vehicles", `{"vehicle_ids": []}`, http.StatusBadRequest, nil, "invalid fleet vehicles"},
+		{"add to a fleet not found", http.MethodPost, "/fleets/9/vehicles", `{"vehicle_ids": [3]}`, http.StatusNotFound, nil, "fleet not found"},
+		{"add to an invalid fleet", http.MethodPost, "/fleets/x/vehicles", `{"vehicle_ids": [3]}`, http.StatusBadRequest, nil, "invalid identifier"},
+
+		// - the vehicle routes within the fleet
+		{"list", http.MethodGet, "/fleets/1/vehicles", "", http.StatusOK, []int{1, 2}, ""},
+		{"list by fuel type", http.MethodGet, "/fleets/1/vehicles/fuel_type/gasoline", "", http.StatusOK, []int{1, 2}, ""},
+		{"list by a fuel type out of the fleet", http.MethodGet, "/fleets/1/vehicles/fuel_type/electric", "", http.StatusNotFound, nil, ""},
+		{"list by brand and years", http.MethodGet, "/fleets/1/vehicles/brand/Ford/between/2016/2022", "", http.StatusOK, []int{2}, ""},
+		{"average speed", http.MethodGet, "/fleets/1/vehicles/average_speed/brand/Ford", "", http.StatusOK, nil, "225.00"},
+		{"average speed of a brand out of the fleet", http.MethodGet, "/fleets/1/vehicles/average_speed/brand/Nissan", "", http.StatusNotFound, nil, ""},
+		{"stats", http.MethodGet, "/fleets/1/vehicles/stats?metrics=count,max:max_speed", "", http.StatusOK, nil, `[{"count":2,"max_max_speed":250}]`},
+		{"histogram", http.MethodGet, "/fleets/1/vehicles/histogram?field=year&edges=2010,2018,2025", "", http.StatusOK, nil,
+			`[{"min":2010,"max":2018,"count":1},{"min":2018,"max":2025,"count":1}]`},
+		{"list of a fleet not found", http.MethodGet, "/fleets/9/vehicles", "", http.StatusNotFound, nil, "fleet not found"},
+
+		// - a removed vehicle is not seen within the fleet anymore
+		{"remove a vehicle", http.MethodDelete, "/fleets/1/vehicles/2", "", http.StatusOK, []int{1}, ""},
+		{"remove a vehicle not in the fleet", http.MethodDelete, "/fleets/1/vehicles/2", "", http.StatusNotFound, nil, "vehicle not in fleet"},
+		{"remove an invalid vehicle", http.MethodDelete, "/fleets/1/vehicles/x", "", http.StatusBadRequest, nil, "invalid vehicle identifier"},
+		{"list after removing", http.MethodGet, "/fleets/1/vehicles", "", http.StatusOK, []int{1}, ""},
+		{"stats after removing", http.MethodGet, "/fleets/1/vehicles/stats", "", http.StatusOK, nil, `[{"count":1}]`},
+		{"the vehicle is kept", http.MethodGet, "/vehicles/fuel_type/gasoline", "", http.StatusOK, []int{1, 2}, ""},
+	}
+	for _, st := range steps {
+		req := httptest.NewRequest(st.method, st.target, strings.NewReader(st.body))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		rt.ServeHTTP(res, req)
+		if res.Code != st.code {
+			t.Fatalf("%s: response = %d %s, want %d", st.name, res.Code, res.Body.String(), st.code)
+		}
+		if st.contains != "" && !strings.Contains(res.Body.String(), st.contains) {
+			t.Errorf("%s: body = %s, want it to contain %s", st.name, res.Body.String(), st.contains)
+		}
+		if st.ids == nil {
+			continue
+		}
+
+		// - the ids of the vehicles listed, or the ones of the fleet
+		var r struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(res.Body.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		var ids []int
+		var vehicles []internal.VehicleJSON
+		var fleet struct {
+			VehicleIDs []int `json:"vehicle_ids"`
+		}
+		if err := json.Unmarshal(r.Data, &vehicles); err == nil {
+			for _, v := range vehicles {
+				ids = append(ids, v.ID)
+			}
+		} else if err = json.Unmarshal(r.Data, &fleet); err == nil {
+			ids = fleet.VehicleIDs
+		}
+		if !slices.Equal(ids, st.ids) {
+			t.Errorf("%s: ids = %v, want %v", st.name, ids, st.ids)
+		}
+	}
+}
+
+func TestRouter_References(t *testing.T) {
+	rt, _ := newTestRouter(t, testVehicles...)
+
+	// the steps run in order on the same vocabularies
+	steps := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		code     int
+		contains string
+	}{
+		{"list", http.MethodGet, "/reference/transmissions", "", http.StatusOK, `"data":["automatic","manual","semi-automatic"]`},
+		{"list a kind not found", http.MethodGet, "/reference/engines", "", http.StatusNotFound, `"code":"reference_kind_not_found"`},
+		{"a value not in the vocabulary", http.MethodPut, "/vehicles/1/update_fuel", `{"fuel_type": "Liquefied Petroleum"}`, http.StatusBadRequest,
+			`"code":"invalid_vehicle_fuel_type"`},
+
+		// - a value is created normalized, and vehicles can have it then
+		{"create", http.MethodPost, "/reference/fuel-types", `{"value": "Liquefied Petroleum"}`, http.StatusCreated, `"data":"liquefied-petroleum"`},
+		{"list after creating", http.MethodGet, "/reference/fuel-types", "", http.StatusOK, `"liquefied-petroleum"`},
+		{"the value in the vocabulary", http.MethodPut, "/vehicles/1/update_fuel", `{"fuel_type": "liquefied petroleum"}`, http.StatusCreated,
+			`"fuel_type":"liquefied-petroleum"`},
+		{"create a color", http.MethodPost, "/reference/colors", `{"value": "dark-green"}`, http.StatusCreated, `"data":"Dark Green"`},
+
+		{"create an existing value", http.MethodPost, "/reference/fuel-types", `{"value": "LIQUEFIED_PETROLEUM"}`, http.StatusConflict,
+			`"code":"reference_already_exists"`},
+		{"create an alias of an existing value", http.MethodPost, "/reference/fuel-types", `{"value": "petrol"}`, http.StatusConflict,
+			`"code":"reference_already_exists"`},
+		{"create in a kind not found", http.MethodPost, "/reference/engines", `{"value": "V8"}`, http.StatusNotFound, `"code":"reference_kind_not_found"`},
+		{"create an empty value", http.MethodPost, "/reference/colors", `{"value": "  "}`, http.StatusBadRequest, `"code":"invalid_reference_value"`},
+		{"create without value", http.MethodPost, "/reference/colors", `{}`, http.StatusBadRequest, `"code":"invalid_request_body"`},
+		{"create with an unknown field", http.MethodPost, "/reference/colors", `{"value": "Olive", "hex": "#808000"}`, http.StatusBadRequest,
+			`"code":"invalid_request_body"`},
+	}
+	for _, st := range steps {
+		req := httptest.NewRequest(st.method, st.target, strings.NewReader(st.body))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		rt.ServeHTTP(res, req)
+		if res.Code != st.code {
+			t.Fatalf("%s: response = %d %s, want %d", st.name, res.Code, res.Body.String(), st.code)
+		}
+		if !strings.Contains(res.Body.String(), st.contains) {
+			t.Errorf("%s: body = %s, want it to contain %s", st.name, res.Body.String(), st.contains)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package eventbus
+
+import (
+	"app/internal"
+	"sync"
+	"time"
+)
+
+// NewVehicleMemory returns a new instance of an in-memory vehicle event bus that keeps the last size events.
+func NewVehicleMemory(size int) *VehicleMemory {
+	if size < 1 {
+		size = 1
+	}
+	return &VehicleMemory{
+		size:        size,
+		events:      make([]internal.VehicleEvent, 0, size),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// subscriberBuffer is the number of events a subscriber can fall behind before it is dropped.
+const subscriberBuffer = 64
+
+// subscriber is an struct that represents a subscription to the bus.
+type subscriber struct {
+	filter internal.VehicleEventFilter
+	ch     chan internal.VehicleEvent
+}
+
+// VehicleMemory is an struct that implements the EventBusVehicle interface in memory.
+type VehicleMemory struct {
+	// mu guards the fields below.
+	mu sync.Mutex
+	// lastId is the id of the last event published.
+	lastId int64
+	// size is the number of events kept to resume subscriptions.
+	size int
+	// events are the last events published, oldest first.
+	events []internal.VehicleEvent
+	// subscribers are the active subscriptions.
+	subscribers map[*subscriber]struct{}
+}
+
+// Publish assigns an id to the event and sends it to the subscribers whose filter matches.
+// Subscribers that are not keeping up are dropped instead of blocking the publisher.
+func (b *VehicleMemory) Publish(e internal.VehicleEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastId++
+	e.ID = b.lastId
+	e.Time = time.Now()
+
+	// keep the event
+	if len(b.events) == b.size {
+		copy(b.events, b.events[1:])
+		b.events = b.events[:len(b.events)-1]
+	}
+	b.events = append(b.events, e)
+
+	// send the event
+	for s := range b.subscribers {
+		if !s.filter.Match(e) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			delete(b.subscribers, s)
+			close(s.ch)
+		}
+	}
+}
+
+// LastId returns the id of the last event published, 0 if there are not any.
+func (b *VehicleMemory) LastId() (id int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastId
+}
+
+// Subscribe returns the kept events after lastId matching the filter and a channel with the next ones.
+func (b *VehicleMemory) Subscribe(f internal.VehicleEventFilter, lastId int64) (replay []internal.VehicleEvent, ch <-chan internal.VehicleEvent, cancel func(), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// replay
+	if lastId > 0 {
+		// ids after the last one published come from before a restart
+		if lastId > b.lastId || (len(b.events) > 0 && b.events[0].ID > lastId+1) {
+			err = internal.ErrEventBusVehicleEventsExpired
+		}
+		for _, e := range b.events {
+			if e.ID > lastId && f.Match(e) {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	// subscribe
+	s := &subscriber{filter: f, ch: make(chan internal.VehicleEvent, subscriberBuffer)}
+	b.subscribers[s] = struct{}{}
+	ch = s.ch
+	cancel = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[s]; ok {
+			delete(b.subscribers, s)
+			close(s.ch)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,24 @@
+package internal
+
+import "time"
+
+// FleetAttributes is an struct that represents the attributes of a fleet.
+type FleetAttributes struct {
+	// Name is the name of the fleet (e.g. a branch or a customer), unique regardless of case.
+	Name string
+	// Description is what the fleet is for, empty if it has none.
+	Description string
+}
+
+// Fleet is an struct that represents a named group of vehicles.
+type Fleet struct {
+	// ID is the unique identifier of the fleet.
+	ID int
+	// Attributes is the attributes of the fleet.
+	Attributes FleetAttributes
+	// VehicleIDs are the ids of the vehicles of the fleet, in ascending order.
+	// A vehicle can belong to any number of fleets.
+	VehicleIDs []int
+	// CreatedAt is when the fleet was created.
+	CreatedAt time.Time
+}
@@ -0,0 +1,32 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrRepositoryFleetNotFound is returned when a fleet is not found.
+	ErrRepositoryFleetNotFound = errors.New("repository: fleet not found")
+	// ErrRepositoryFleetNameAlreadyExists is returned when another fleet has the same name.
+	ErrRepositoryFleetNameAlreadyExists = errors.New("repository: fleet name already exists")
+	// ErrRepositoryFleetVehicleNotFound is returned when a vehicle is not in a fleet.
+	ErrRepositoryFleetVehicleNotFound = errors.New("repository: fleet vehicle not found")
+)
+
+// RepositoryFleet is the interface that wraps the basic methods for a fleet repository.
+type RepositoryFleet interface {
+	// FindAll returns all fleets
+	FindAll() (f []Fleet, err error)
+	// FindById returns the fleet with the given id
+	FindById(id int) (f Fleet, err error)
+	// Insert saves a new fleet, assigning its id
+	Insert(f Fleet) (nf Fleet, err error)
+	// Update replaces the attributes of a fleet, keeping its vehicles
+	Update(id int, a FleetAttributes) (uf Fleet, err error)
+	// Delete removes a fleet, not its vehicles
+	Delete(id int) (err error)
+	// AddVehicles adds vehicles to a fleet, the ones already in it are skipped
+	AddVehicles(id int, vehicleIds []int) (uf Fleet, err error)
+	// RemoveVehicle removes a vehicle from a fleet
+	RemoveVehicle(id int, vehicleId int) (uf Fleet, err error)
+	// RemoveVehicleFromAll removes a vehicle from every fleet it belongs to
+	RemoveVehicleFromAll(vehicleId int) (err error)
+}
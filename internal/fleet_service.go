@@ -0,0 +1,31 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrServiceFleetNotFound is returned when a fleet is not found.
+	ErrServiceFleetNotFound = errors.New("service: fleet not found")
+	// ErrServiceFleetNameAlreadyExists is returned when a fleet is named as another one.
+	ErrServiceFleetNameAlreadyExists = errors.New("service: fleet name already exists")
+	// ErrServiceFleetVehicleNotFound is returned when a vehicle that is not in a fleet is removed from it.
+	ErrServiceFleetVehicleNotFound = errors.New("service: fleet vehicle not found")
+	ErrServiceInvalidFleetName     = errors.New("service: invalid fleet name")
+	ErrServiceInvalidFleetVehicles = errors.New("service: invalid fleet vehicles")
+)
+
+// ServiceFleet is the interface that wraps the basic methods for a fleet service.
+type ServiceFleet interface {
+	// FindAll returns all fleets
+	FindAll() (f []Fleet, err error)
+	FindById(id int) (f Fleet, err error)
+	Insert(a FleetAttributes) (nf Fleet, err error)
+	Update(id int, a FleetAttributes) (uf Fleet, err error)
+	// Delete removes a fleet, its vehicles are kept
+	Delete(id int) (err error)
+	// AddVehicles adds existing vehicles to a fleet
+	AddVehicles(id int, vehicleIds []int) (uf Fleet, err error)
+	// RemoveVehicle removes a vehicle from a fleet, the vehicle is kept
+	RemoveVehicle(id int, vehicleId int) (uf Fleet, err error)
+	// Vehicles returns a vehicle service that only sees the vehicles of a fleet, as they were when it is called
+	Vehicles(id int) (sv ServiceVehicle, err error)
+}
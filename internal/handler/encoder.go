@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Envelope is an struct that represents the body of a successful response.
+type Envelope struct {
+	// Message is the description of the result.
+	Message string
+	// Data is the payload of the response, nil if there is not any.
+	Data any
+}
+
+// Encoder is the interface that wraps the basic methods to write a response in a media type.
+type Encoder interface {
+	// ContentType returns the value of the Content-Type header of the responses.
+	ContentType() string
+	// MediaTypes returns the media types of the Accept header the encoder is chosen for.
+	MediaTypes() []string
+	// Encode writes the envelope to w.
+	Encode(w io.Writer, e Envelope) (err error)
+}
+
+// NewEncoders returns a new set of encoders, the first one is the default.
+func NewEncoders(encoders ...Encoder) *Encoders {
+	return &Encoders{encoders: encoders}
+}
+
+// DefaultEncoders returns the encoders supported by default: json (the default), xml, yaml, msgpack and csv.
+func DefaultEncoders() *Encoders {
+	return NewEncoders(EncoderJSON{}, EncoderXML{}, EncoderYAML{}, EncoderMsgPack{}, EncoderCSV{})
+}
+
+// Encoders is an struct that contains the encoders a response can be written with.
+type Encoders struct {
+	// encoders is the list of encoders, the first one is the default.
+	encoders []Encoder
+}
+
+// Register adds an encoder, replacing the one with the same content type.
+func (es *Encoders) Register(e Encoder) {
+	for i, registered := range es.encoders {
+		if registered.ContentType() == e.ContentType() {
+			es.encoders[i] = e
+			return
+		}
+	}
+	es.encoders = append(es.encoders, e)
+}
+
+// Negotiate returns the encoder that best matches the Accept header, the default one if the header is empty.
+func (es *Encoders) Negotiate(accept string) (e Encoder, ok bool) {
+	if len(es.encoders) == 0 {
+		return
+	}
+	if strings.TrimSpace(accept) == "" {
+		return es.encoders[0], true
+	}
+
+	for _, mediaType := range parseAccept(accept) {
+		if mediaType == "*/*" {
+			return es.encoders[0], true
+		}
+		for _, encoder := range es.encoders {
+			for _, mt := range encoder.MediaTypes() {
+				if mt == mediaType || (strings.HasSuffix(mediaType, "/*") && strings.HasPrefix(mt, strings.TrimSuffix(mediaType, "*"))) {
+					return encoder, true
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// parseAccept returns the media types of an Accept header sorted by quality, without the ones with quality 0.
+func parseAccept(accept string) (mediaTypes []string) {
+	type mediaRange struct {
+		mediaType string
+		q         float64
+	}
+	ranges := make([]mediaRange, 0)
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		r := mediaRange{mediaType: strings.ToLower(strings.TrimSpace(params[0])), q: 1}
+		for _, param := range params[1:] {
+			k, v, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if strings.TrimSpace(k) == "q" {
+				if q, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+					r.q = q
+				}
+			}
+		}
+		if r.mediaType == "" || r.q <= 0 {
+			continue
+		}
+		ranges = append(ranges, r)
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	mediaTypes = make([]string, len(ranges))
+	for i, r := range ranges {
+		mediaTypes[i] = r.mediaType
+	}
+	return
+}
+
+// respond writes a successful response with the encoder negotiated with the Accept header of the request.
+func respond(ctx *gin.Context, es *Encoders, code int, e Envelope) {
+	encoder, ok := es.Negotiate(ctx.GetHeader("Accept"))
+	if !ok {
+		ctx.JSON(http.StatusNotAcceptable, gin.H{"error": "not acceptable", "code": "not_acceptable"})
+		return
+	}
+
+	ctx.Header("Content-Type", encoder.ContentType())
+	ctx.Status(code)
+	if err := encoder.Encode(ctx.Writer, e); err != nil {
+		_ = ctx.Error(err)
+	}
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ugorji/go/codec"
+	"gopkg.in/yaml.v3"
+)
+
+// envelopeMap returns the envelope as a map, without data if there is not any.
+func envelopeMap(e Envelope) map[string]any {
+	m := map[string]any{"message": e.Message}
+	if e.Data != nil {
+		m["data"] = e.Data
+	}
+	return m
+}
+
+// EncoderJSON is an struct that writes responses in json.
+type EncoderJSON struct{}
+
+// ContentType returns the content type of json.
+func (EncoderJSON) ContentType() string {
+	return "application/json; charset=utf-8"
+}
+
+// MediaTypes returns the media types of json.
+func (EncoderJSON) MediaTypes() []string {
+	return []string{"application/json"}
+}
+
+// Encode writes the envelope in json.
+func (EncoderJSON) Encode(w io.Writer, e Envelope) (err error) {
+	b, err := json.Marshal(envelopeMap(e))
+	if err != nil {
+		return
+	}
+	_, err = w.Write(b)
+	return
+}
+
+// EncoderXML is an struct that writes responses in xml.
+// The envelope is a response element, the items of a list of data are item elements.
+type EncoderXML struct{}
+
+// ContentType returns the content type of xml.
+func (EncoderXML) ContentType() string {
+	return "application/xml; charset=utf-8"
+}
+
+// MediaTypes returns the media types of xml.
+func (EncoderXML) MediaTypes() []string {
+	return []string{"application/xml", "text/xml"}
+}
+
+// Encode writes the envelope in xml.
+func (EncoderXML) Encode(w io.Writer, e Envelope) (err error) {
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return
+	}
+
+	enc := xml.NewEncoder(w)
+	response := xml.StartElement{Name: xml.Name{Local: "response"}}
+	if err = enc.EncodeToken(response); err != nil {
+		return
+	}
+	if err = enc.EncodeElement(e.Message, xml.StartElement{Name: xml.Name{Local: "message"}}); err != nil {
+		return
+	}
+
+	// data
+	if e.Data != nil {
+		data := xml.StartElement{Name: xml.Name{Local: "data"}}
+		rv := reflect.ValueOf(e.Data)
+		if rv.Kind() == reflect.Slice {
+			if err = enc.EncodeToken(data); err != nil {
+				return
+			}
+			for i := 0; i < rv.Len(); i++ {
+				if err = enc.EncodeElement(rv.Index(i).Interface(), xml.StartElement{Name: xml.Name{Local: "item"}}); err != nil {
+					return
+				}
+			}
+			if err = enc.EncodeToken(data.End()); err != nil {
+				return
+			}
+		} else if err = enc.EncodeElement(e.Data, data); err != nil {
+			return
+		}
+	}
+
+	if err = enc.EncodeToken(response.End()); err != nil {
+		return
+	}
+	err = enc.Flush()
+	return
+}
+
+// EncoderYAML is an struct that writes responses in yaml.
+type EncoderYAML struct{}
+
+// ContentType returns the content type of yaml.
+func (EncoderYAML) ContentType() string {
+	return "application/yaml; charset=utf-8"
+}
+
+// MediaTypes returns the media types of yaml.
+func (EncoderYAML) MediaTypes() []string {
+	return []string{"application/yaml", "application/x-yaml", "text/yaml"}
+}
+
+// Encode writes the envelope in yaml.
+func (EncoderYAML) Encode(w io.Writer, e Envelope) (err error) {
+	enc := yaml.NewEncoder(w)
+	if err = enc.Encode(envelopeMap(e)); err != nil {
+		return
+	}
+	err = enc.Close()
+	return
+}
+
+// EncoderMsgPack is an struct that writes responses in MessagePack.
+// Fields are named after their json tags.
+type EncoderMsgPack struct{}
+
+// ContentType returns the content type of MessagePack.
+func (EncoderMsgPack) ContentType() string {
+	return "application/msgpack"
+}
+
+// MediaTypes returns the media types of MessagePack.
+func (EncoderMsgPack) MediaTypes() []string {
+	return []string{"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"}
+}
+
+// Encode writes the envelope in MessagePack.
+func (EncoderMsgPack) Encode(w io.Writer, e Envelope) (err error) {
+	var h codec.MsgpackHandle
+	err = codec.NewEncoder(w, &h).Encode(envelopeMap(e))
+	return
+}
+
+// EncoderCSV is an struct that writes responses in csv.
+// Only the data is written, a header with the json names of the fields and a row per item.
+// Responses without data are written as a single message column.
+type EncoderCSV struct{}
+
+// ContentType returns the content type of csv.
+func (EncoderCSV) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+// MediaTypes returns the media types of csv.
+func (EncoderCSV) MediaTypes() []string {
+	return []string{"text/csv"}
+}
+
+// Encode writes the data of the envelope in csv.
+func (EncoderCSV) Encode(w io.Writer, e Envelope) (err error) {
+	cw := csv.NewWriter(w)
+
+	// rows
+	var rows []reflect.Value
+	rv := reflect.ValueOf(e.Data)
+	switch {
+	case e.Data == nil:
+		cw.Write([]string{"message"})
+		cw.Write([]string{e.Message})
+		cw.Flush()
+		return cw.Error()
+	case rv.Kind() == reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			rows = append(rows, reflect.Indirect(rv.Index(i)))
+		}
+	default:
+		rows = append(rows, reflect.Indirect(rv))
+	}
+
+	// header, from the fields of the items (or a single value column)
+	var fields [][]int
+	var header []string
+	if len(rows) > 0 && rows[0].Kind() == reflect.Struct {
+		fields, header = csvFields(rows[0].Type())
+	} else {
+		header = []string{"value"}
+	}
+	if err = cw.Write(header); err != nil {
+		return
+	}
+
+	for _, row := range rows {
+		record := make([]string, 0, len(header))
+		if row.Kind() == reflect.Struct {
+			for _, i := range fields {
+				record = append(record, csvValue(row.FieldByIndex(i)))
+			}
+		} else {
+			record = append(record, csvValue(row))
+		}
+		if err = cw.Write(record); err != nil {
+			return
+		}
+	}
+
+	cw.Flush()
+	err = cw.Error()
+	return
+}
+
+// csvFields returns the indexes of the fields of a struct written to csv and their names, taken from the json tags.
+// The fields of embedded structs are written as fields of the struct, as in json.
+func csvFields(rt reflect.Type) (fields [][]int, names []string) {
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			embedded, embeddedNames := csvFields(f.Type)
+			for _, index := range embedded {
+				fields = append(fields, append([]int{i}, index...))
+			}
+			names = append(names, embeddedNames...)
+			continue
+		}
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, []int{i})
+		names = append(names, name)
+	}
+	return
+}
+
+// csvValue returns the text of a value for a csv cell, empty for nil pointers.
+func csvValue(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.String:
+		return v.String()
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
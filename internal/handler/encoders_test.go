@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"app/internal"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEncoders_Negotiate(t *testing.T) {
+	es := DefaultEncoders()
+
+	tests := []struct {
+		name   string
+		accept string
+		// contentType is the content type of the encoder negotiated, empty if none is acceptable
+		contentType string
+	}{
+		{"without accept", "", "application/json; charset=utf-8"},
+		{"any", "*/*", "application/json; charset=utf-8"},
+		{"json", "application/json", "application/json; charset=utf-8"},
+		{"xml", "application/xml", "application/xml; charset=utf-8"},
+		{"xml as text", "text/xml", "application/xml; charset=utf-8"},
+		{"yaml", "application/x-yaml", "application/yaml; charset=utf-8"},
+		{"msgpack", "application/vnd.msgpack", "application/msgpack"},
+		{"csv", "text/csv", "text/csv; charset=utf-8"},
+		{"case insensitive", "Text/CSV", "text/csv; charset=utf-8"},
+		{"the first of the same quality", "text/yaml, text/csv", "application/yaml; charset=utf-8"},
+		{"the highest quality", "application/xml;q=0.5, text/csv;q=0.9", "text/csv; charset=utf-8"},
+		{"a range of a type", "text/*", "application/xml; charset=utf-8"},
+		{"an unsupported type before a supported one", "image/png, application/msgpack;q=0.1", "application/msgpack"},
+		{"an excluded type", "text/csv;q=0, application/json;q=0.2", "application/json; charset=utf-8"},
+		{"unsupported", "image/png", ""},
+		{"only excluded", "application/json;q=0", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := es.Negotiate(tt.accept)
+			if ok != (tt.contentType != "") {
+				t.Fatalf("ok = %t, want %t", ok, tt.contentType != "")
+			}
+			if ok && e.ContentType() != tt.contentType {
+				t.Errorf("content type = %s, want %s", e.ContentType(), tt.contentType)
+			}
+		})
+	}
+}
+
+func TestEncoders_Register(t *testing.T) {
+	es := NewEncoders(EncoderJSON{})
+	es.Register(EncoderCSV{})
+	if e, ok := es.Negotiate("text/csv"); !ok || e.ContentType() != "text/csv; charset=utf-8" {
+		t.Errorf("encoder = %v, %t, want the csv one", e, ok)
+	}
+	if _, ok := NewEncoders().Negotiate(""); ok {
+		t.Error("ok = true without encoders")
+	}
+}
+
+func TestEncoders_Encode(t *testing.T) {
+	vehicles := []internal.VehicleJSON{
+		{ID: 1, Brand: "Ford", Model: "Focus", Year: 2015, Height: 150.5, LengthUnit: "cm", WeightUnit: "kg"},
+		{ID: 3, Brand: "Nissan", Model: "Leaf, e+", Year: 2021, BatteryCapacity: 62, LengthUnit: "cm", WeightUnit: "kg"},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := (EncoderJSON{}).Encode(&b, Envelope{Message: "found", Data: vehicles}); err != nil {
+			t.Fatal(err)
+		}
+		var d struct {
+			Message string           `json:"message"`
+			Data    []map[string]any `json:"data"`
+		}
+		if err := json.Unmarshal(b.Bytes(), &d); err != nil {
+			t.Fatal(err)
+		}
+		if d.Message != "found" || len(d.Data) != 2 || d.Data[1]["model"] != "Leaf, e+" || d.Data[0]["height"] != 150.5 {
+			t.Errorf("decoded = %+v", d)
+		}
+	})
+
+	t.Run("xml", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := (EncoderXML{}).Encode(&b, Envelope{Message: "found", Data: vehicles}); err != nil {
+			t.Fatal(err)
+		}
+		var d struct {
+			XMLName xml.Name               `xml:"response"`
+			Message string                 `xml:"message"`
+			Items   []internal.VehicleJSON `xml:"data>item"`
+		}
+		if err := xml.Unmarshal(b.Bytes(), &d); err != nil {
+			t.Fatal(err)
+		}
+		if d.Message != "found" || !reflect.DeepEqual(d.Items, vehicles) {
+			t.Errorf("decoded = %+v", d)
+		}
+	})
+
+	t.Run("xml of a single value", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := (EncoderXML{}).Encode(&b, Envelope{Message: "found", Data: vehicles[0]}); err != nil {
+			t.Fatal(err)
+		}
+		var d struct {
+			Data internal.VehicleJSON `xml:"data"`
+		}
+		if err := xml.Unmarshal(b.Bytes(), &d); err != nil {
+			t.Fatal(err)
+		}
+		if d.Data != vehicles[0] {
+			t.Errorf("data = %+v, want %+v", d.Data, vehicles[0])
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := (EncoderYAML{}).Encode(&b, Envelope{Message: "found", Data: vehicles}); err != nil {
+			t.Fatal(err)
+		}
+		var d struct {
+			Message string                 `yaml:"message"`
+			Data    []internal.VehicleJSON `yaml:"data"`
+		}
+		if err := yaml.Unmarshal(b.Bytes(), &d); err != nil {
+			t.Fatal(err)
+		}
+		if d.Message != "found" || !reflect.DeepEqual(d.Data, vehicles) {
+			t.Errorf("decoded = %+v", d)
+		}
+	})
+
+	t.Run("msgpack", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := (EncoderMsgPack{}).Encode(&b, Envelope{Message: "found", Data: vehicles}); err != nil {
+			t.Fatal(err)
+		}
+		var d struct {
+			Message string                 `codec:"message"`
+			Data    []internal.VehicleJSON `codec:"data"`
+		}
+		var h codec.MsgpackHandle
+		if err := codec.NewDecoderBytes(b.Bytes(), &h).Decode(&d); err != nil {
+			t.Fatal(err)
+		}
+		if d.Message != "found" || !reflect.DeepEqual(d.Data, vehicles) {
+			t.Errorf("decoded = %+v", d)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			e       Envelope
+			records [][]string
+		}{
+			{"a list of structs", Envelope{Data: []struct {
+				ID    int     `json:"id"`
+				Model string  `json:"model"`
+				Speed float64 `json:"speed"`
+				Owner *int    `json:"owner_id"`
+				Skip  string  `json:"-"`
+			}{{1, "Focus", 200.5, nil, "x"}, {3, "Leaf, e+", 150, new(int), "x"}}},
+				[][]string{{"id", "model", "speed", "owner_id"}, {"1", "Focus", "200.5", ""}, {"3", "Leaf, e+", "150", "0"}}},
+			{"a single struct", Envelope{Data: &struct {
+				Name string `json:"name"`
+			}{"Fords"}}, [][]string{{"name"}, {"Fords"}}},
+			{"a list of values", Envelope{Data: []string{"gasoline", "electric"}}, [][]string{{"value"}, {"gasoline"}, {"electric"}}},
+			{"without data", Envelope{Message: "average speed is 225.00"}, [][]string{{"message"}, {"average speed is 225.00"}}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var b bytes.Buffer
+				if err := (EncoderCSV{}).Encode(&b, tt.e); err != nil {
+					t.Fatal(err)
+				}
+				records, err := csv.NewReader(&b).ReadAll()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(records, tt.records) {
+					t.Errorf("records = %q, want %q", records, tt.records)
+				}
+			})
+		}
+	})
+}
+
+func TestVehicleDefault_Negotiation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hd := NewVehicleDefault(newTestService(), nil, nil)
+	rt := gin.New()
+	rt.GET("/vehicles/fuel_type/:type", hd.GetAllByFuelType())
+
+	tests := []struct {
+		name        string
+		accept      string
+		code        int
+		contentType string
+	}{
+		{"json by default", "", http.StatusOK, "application/json; charset=utf-8"},
+		{"xml", "application/xml", http.StatusOK, "application/xml; charset=utf-8"},
+		{"yaml", "application/yaml", http.StatusOK, "application/yaml; charset=utf-8"},
+		{"msgpack", "application/msgpack", http.StatusOK, "application/msgpack"},
+		{"csv", "text/csv", http.StatusOK, "text/csv; charset=utf-8"},
+		{"not acceptable", "image/png", http.StatusNotAcceptable, "application/json; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/vehicles/fuel_type/gasoline", nil)
+			req.Header.Set("Accept", tt.accept)
+			res := httptest.NewRecorder()
+			rt.ServeHTTP(res, req)
+			if res.Code != tt.code || res.Header().Get("Content-Type") != tt.contentType {
+				t.Errorf("response = %d %s, want %d %s", res.Code, res.Header().Get("Content-Type"), tt.code, tt.contentType)
+			}
+		})
+	}
+}
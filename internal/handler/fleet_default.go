@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FleetJSON is an struct that represents a fleet in json format.
+type FleetJSON struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	VehicleIDs  []int     `json:"vehicle_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BodyRequestFleet is an struct that represents the body to create or update a fleet.
+type BodyRequestFleet struct {
+	Name        *string `json:"name" request:"required"`
+	Description *string `json:"description"`
+}
+
+// attributes returns the fleet attributes of the body, it must have been decoded with every required field.
+func (b BodyRequestFleet) attributes() internal.FleetAttributes {
+	a := internal.FleetAttributes{Name: *b.Name}
+	if b.Description != nil {
+		a.Description = *b.Description
+	}
+	return a
+}
+
+// BodyRequestFleetVehicles is an struct that represents the body to add vehicles to a fleet.
+type BodyRequestFleetVehicles struct {
+	VehicleIDs []int `json:"vehicle_ids" request:"required"`
+}
+
+// serializeFleet returns the json representation of a fleet.
+func serializeFleet(f internal.Fleet) FleetJSON {
+	fj := FleetJSON{
+		ID:          f.ID,
+		Name:        f.Attributes.Name,
+		Description: f.Attributes.Description,
+		VehicleIDs:  f.VehicleIDs,
+		CreatedAt:   f.CreatedAt,
+	}
+	if fj.VehicleIDs == nil {
+		fj.VehicleIDs = []int{}
+	}
+	return fj
+}
+
+// NewFleetDefault returns a new instance of a fleet handler.
+// The vehicles of the fleets are written as the vehicle handler does, with the default encoders if enc or st are nil.
+func NewFleetDefault(sv internal.ServiceFleet, enc *Encoders, st *StreamEncoders) *FleetDefault {
+	if enc == nil {
+		enc = DefaultEncoders()
+	}
+	if st == nil {
+		st = DefaultStreamEncoders()
+	}
+	return &FleetDefault{sv: sv, enc: enc, st: st}
+}
+
+// FleetDefault is an struct that contains handlers for fleets.
+type FleetDefault struct {
+	sv internal.ServiceFleet
+	// enc are the encoders the vehicles of a fleet can be written with.
+	enc *Encoders
+	// st are the encoders the vehicles of a fleet can be streamed with.
+	st *StreamEncoders
+}
+
+// GetAll returns all fleets.
+func (hd *FleetDefault) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		fleets, err := hd.sv.FindAll()
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			return
+		}
+
+		data := make([]FleetJSON, len(fleets))
+		for i, f := range fleets {
+			data[i] = serializeFleet(f)
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "fleets found", "data": data})
+	}
+}
+
+// Get returns a fleet.
+func (hd *FleetDefault) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		f, err := hd.sv.FindById(id)
+		if err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "fleet found", "data": serializeFleet(f)})
+	}
+}
+
+// Create creates a fleet without vehicles.
+func (hd *FleetDefault) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var body BodyRequestFleet
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		f, err := hd.sv.Insert(body.attributes())
+		if err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, gin.H{"message": "fleet created", "data": serializeFleet(f)})
+	}
+}
+
+// Update replaces the name and description of a fleet.
+func (hd *FleetDefault) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		var body BodyRequestFleet
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		f, err := hd.sv.Update(id, body.attributes())
+		if err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "fleet updated", "data": serializeFleet(f)})
+	}
+}
+
+// Delete removes a fleet, not its vehicles.
+func (hd *FleetDefault) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		if err := hd.sv.Delete(id); err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// AddVehicles adds the vehicles of the body to a fleet.
+func (hd *FleetDefault) AddVehicles() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		var body BodyRequestFleetVehicles
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		f, err := hd.sv.AddVehicles(id, body.VehicleIDs)
+		if err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "vehicles added to fleet", "data": serializeFleet(f)})
+	}
+}
+
+// RemoveVehicle removes a vehicle from a fleet, the vehicle is kept.
+func (hd *FleetDefault) RemoveVehicle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		vehicleId, err := strconv.Atoi(ctx.Param("vehicle_id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle identifier", "code": "invalid_vehicle_identifier"})
+			return
+		}
+
+		f, err := hd.sv.RemoveVehicle(id, vehicleId)
+		if err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "vehicle removed from fleet", "data": serializeFleet(f)})
+	}
+}
+
+// Vehicles returns the handler made by h of a vehicle handler that only sees the vehicles of the fleet
+// of the id param (e.g. (*VehicleDefault).CalculateAverageSpeedByBrand for the average speed within the fleet).
+func (hd *FleetDefault) Vehicles(h func(hv *VehicleDefault) gin.HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		sv, err := hd.sv.Vehicles(id)
+		if err != nil {
+			fleetErrorResponse(ctx, err)
+			return
+		}
+
+		h(NewVehicleDefault(sv, hd.enc, hd.st))(ctx)
+	}
+}
+
+// fleetErrorResponse writes the response for an error of the fleet service.
+func fleetErrorResponse(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, internal.ErrServiceInvalidFleetName):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid fleet name", "code": "invalid_fleet_name"})
+	case errors.Is(err, internal.ErrServiceInvalidFleetVehicles):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid fleet vehicles", "code": "invalid_fleet_vehicles"})
+	case errors.Is(err, internal.ErrServiceFleetNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "fleet not found", "code": "fleet_not_found"})
+	case errors.Is(err, internal.ErrServiceFleetVehicleNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not in fleet", "code": "fleet_vehicle_not_found"})
+	case errors.Is(err, internal.ErrServiceFleetNameAlreadyExists):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "fleet name already exists", "code": "fleet_name_already_exists"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+	}
+}
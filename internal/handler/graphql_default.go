@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"app/internal"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// NewGraphQLDefault returns a new instance of a graphql handler whose resolvers call the vehicle service.
+func NewGraphQLDefault(sv internal.ServiceVehicle) (hd *GraphQLDefault, err error) {
+	schema, err := NewGraphQLSchema(sv)
+	if err != nil {
+		return
+	}
+
+	hd = &GraphQLDefault{schema: schema}
+	return
+}
+
+// GraphQLDefault is an struct that serves graphql queries and mutations over http.
+type GraphQLDefault struct {
+	// schema is the graphql schema of the vehicles.
+	schema graphql.Schema
+}
+
+// BodyRequestGraphQL is an struct that represents a graphql request.
+type BodyRequestGraphQL struct {
+	Query         *string        `json:"query" request:"required"`
+	OperationName *string        `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Query executes a graphql request, sent as a json body (POST) or as query parameters (GET).
+// Mutations are only allowed with POST.
+func (hd *GraphQLDefault) Query() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		var query, operationName string
+		var variables map[string]any
+		switch ctx.Request.Method {
+		case http.MethodGet:
+			query = ctx.Query("query")
+			operationName = ctx.Query("operationName")
+			if query == "" {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "query is required", "code": "invalid_graphql_query"})
+				return
+			}
+			if v := ctx.Query("variables"); v != "" {
+				if err := json.Unmarshal([]byte(v), &variables); err != nil {
+					ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid variables", "code": "invalid_graphql_variables"})
+					return
+				}
+			}
+			if isGraphQLMutation(query, operationName) {
+				ctx.JSON(http.StatusMethodNotAllowed, gin.H{"error": "mutations are only allowed with POST", "code": "method_not_allowed"})
+				return
+			}
+		default:
+			var body BodyRequestGraphQL
+			if !bindStrict(ctx, &body) {
+				return
+			}
+			query = *body.Query
+			if body.OperationName != nil {
+				operationName = *body.OperationName
+			}
+			variables = body.Variables
+		}
+
+		// process
+		result := graphql.Do(graphql.Params{
+			Schema:         hd.schema,
+			RequestString:  query,
+			OperationName:  operationName,
+			VariableValues: variables,
+			Context:        ctx.Request.Context(),
+		})
+
+		// response
+		// - errors are part of the result, as graphql clients expect
+		ctx.JSON(http.StatusOK, result)
+	}
+}
+
+// isGraphQLMutation returns true if the operation that would be executed is a mutation.
+// Queries that can not be parsed are left for the executor to report.
+func isGraphQLMutation(query, operationName string) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return false
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName != "" && (op.Name == nil || op.Name.Value != operationName) {
+			continue
+		}
+		if op.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+	return false
+}
+
+// GraphQLError is an error returned by a resolver, with a code clients can switch on.
+type GraphQLError struct {
+	// Message is the message of the error.
+	Message string
+	// Code is the code of the error (e.g. NOT_FOUND).
+	Code string
+	// ErrorCode is the stable code of the service error, as in the error responses of the rest api
+	// (e.g. vehicle_not_found), empty if the error was not caused by any.
+	ErrorCode string
+}
+
+// Error returns the message of the error.
+func (e *GraphQLError) Error() string {
+	return e.Message
+}
+
+// Extensions returns the codes of the error, added to the extensions of the graphql error.
+func (e *GraphQLError) Extensions() map[string]any {
+	ext := map[string]any{"code": e.Code}
+	if e.ErrorCode != "" {
+		ext["error_code"] = e.ErrorCode
+	}
+	return ext
+}
+
+// graphQLError maps an error of the vehicle service to the error returned to graphql clients.
+func graphQLError(err error) error {
+	switch {
+	case errors.Is(err, internal.ErrServiceVehicleNotFound):
+		return &GraphQLError{Message: "vehicle not found", Code: "NOT_FOUND", ErrorCode: "vehicle_not_found"}
+	case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+		return &GraphQLError{Message: "vehicles not found", Code: "NOT_FOUND", ErrorCode: "vehicles_not_found"}
+	case errors.Is(err, internal.ErrServiceVehicleIdAlreadyExists):
+		return &GraphQLError{Message: "vehicle already exists", Code: "CONFLICT", ErrorCode: "vehicle_already_exists"}
+	}
+	if msg, code, ok := invalidVehicleMessage(err); ok {
+		return &GraphQLError{Message: msg, Code: "BAD_USER_INPUT", ErrorCode: code}
+	}
+	return &GraphQLError{Message: "an unexpected error occurred", Code: "INTERNAL"}
+}
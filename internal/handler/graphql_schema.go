@@ -0,0 +1,436 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/graphql-go/graphql"
+)
+
+// graphQLVehicle is the graphql type of a vehicle, resolved from a VehicleJSON by its json tags.
+// The optional fields are null when they are omitted from the json.
+var graphQLVehicle = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Vehicle",
+	Fields: graphql.Fields{
+		"id":                 &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"brand":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"model":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"registration":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"year":               &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"color":              &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"max_speed":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"fuel_type":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"transmission":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"passengers":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"height":             &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "Height in centimeters"},
+		"width":              &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "Width in centimeters"},
+		"weight":             &graphql.Field{Type: graphql.NewNonNull(graphql.Float), Description: "Weight in kilograms"},
+		"battery_capacity":   &graphql.Field{Type: graphql.Float, Description: "Capacity of the battery in kWh, 0 if the vehicle is not electric or hybrid"},
+		"range":              &graphql.Field{Type: graphql.Int, Description: "Electric range in km, 0 if the vehicle is not electric or hybrid"},
+		"charging_connector": &graphql.Field{Type: graphql.String},
+		"length_unit":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"weight_unit":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"owner_id": &graphql.Field{Type: graphql.Int, Description: "Identifier of the owner, null if the vehicle has none",
+			Resolve: graphQLOmitted(func(v internal.VehicleJSON) any { return v.OwnerID })},
+		"source": &graphql.Field{Type: graphql.String, Description: "Data source the vehicle was loaded from, null if it was created through the api",
+			Resolve: graphQLOmitted(func(v internal.VehicleJSON) any { return v.Source })},
+	},
+})
+
+// graphQLOmitted returns a resolver of a field of a vehicle that is null when it has the zero value,
+// as the fields omitted from its json.
+func graphQLOmitted(field func(v internal.VehicleJSON) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		var value any
+		switch v := p.Source.(type) {
+		case internal.VehicleJSON:
+			value = field(v)
+		case *internal.VehicleJSON:
+			value = field(*v)
+		default:
+			return nil, fmt.Errorf("graphql: %T is not a vehicle", p.Source)
+		}
+		if reflect.ValueOf(value).IsZero() {
+			return nil, nil
+		}
+		return value, nil
+	}
+}
+
+// graphQLVehiclePage is the graphql type of a page of vehicles.
+var graphQLVehiclePage = graphql.NewObject(graphql.ObjectConfig{
+	Name: "VehiclePage",
+	Fields: graphql.Fields{
+		"total":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "Number of vehicles matching the filter, before pagination"},
+		"vehicles": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphQLVehicle)))},
+	},
+})
+
+// graphQLVehicleFilter is the graphql input to filter vehicles, all given conditions must match.
+// Categorical fields are compared for equality and numeric ranges are inclusive.
+var graphQLVehicleFilter = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "VehicleFilter",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"brand":              &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"model":              &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"color":              &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"fuel_type":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"transmission":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"charging_connector": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"year_min":           &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"year_max":           &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"max_speed_min":      &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"max_speed_max":      &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"passengers_min":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"passengers_max":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"height_min":         &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"height_max":         &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"width_min":          &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"width_max":          &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"weight_min":         &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"weight_max":         &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"range_min":          &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"range_max":          &graphql.InputObjectFieldConfig{Type: graphql.Int},
+	},
+})
+
+// graphQLVehicleInput is the graphql input to create a vehicle.
+var graphQLVehicleInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "VehicleInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"brand":              &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"model":              &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"registration":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"year":               &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+		"color":              &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"max_speed":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+		"fuel_type":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"transmission":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"passengers":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+		"height":             &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+		"width":              &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+		"weight":             &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+		"battery_capacity":   &graphql.InputObjectFieldConfig{Type: graphql.Float},
+		"range":              &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		"charging_connector": &graphql.InputObjectFieldConfig{Type: graphql.String},
+	},
+})
+
+// NewGraphQLSchema returns the graphql schema of the vehicles, resolved with the given service.
+func NewGraphQLSchema(sv internal.ServiceVehicle) (s graphql.Schema, err error) {
+	r := &graphQLResolver{sv: sv}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"vehicle": &graphql.Field{
+				Type:        graphQLVehicle,
+				Description: "Vehicle with the given id",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.vehicle,
+			},
+			"vehicles": &graphql.Field{
+				Type:        graphql.NewNonNull(graphQLVehiclePage),
+				Description: "Vehicles matching the filter, ordered by id",
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: graphQLVehicleFilter},
+					"limit":  &graphql.ArgumentConfig{Type: graphql.Int, Description: "Maximum number of vehicles, all if not given"},
+					"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				},
+				Resolve: r.vehicles,
+			},
+			"average_speed_by_brand": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: "Average max speed of the vehicles of a brand",
+				Args: graphql.FieldConfigArgument{
+					"brand": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.averageSpeedByBrand,
+			},
+			"average_capacity_by_brand": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: "Average passengers of the vehicles of a brand",
+				Args: graphql.FieldConfigArgument{
+					"brand": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.averageCapacityByBrand,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"create_vehicle": &graphql.Field{
+				Type: graphql.NewNonNull(graphQLVehicle),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphQLVehicleInput)},
+				},
+				Resolve: r.createVehicle,
+			},
+			"create_vehicles": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphQLVehicle))),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphQLVehicleInput)))},
+				},
+				Resolve: r.createVehicles,
+			},
+			"update_max_speed": &graphql.Field{
+				Type: graphql.NewNonNull(graphQLVehicle),
+				Args: graphql.FieldConfigArgument{
+					"id":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"max_speed": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.updateMaxSpeed,
+			},
+			"update_fuel_type": &graphql.Field{
+				Type:        graphql.NewNonNull(graphQLVehicle),
+				Description: "Sets the fuel type of a vehicle, the energy attributes replacing the ones it had",
+				Args: graphql.FieldConfigArgument{
+					"id":                 &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"fuel_type":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"battery_capacity":   &graphql.ArgumentConfig{Type: graphql.Float},
+					"range":              &graphql.ArgumentConfig{Type: graphql.Int},
+					"charging_connector": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.updateFuelType,
+			},
+			"delete_vehicle": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Int),
+				Description: "Deletes a vehicle, returning its id",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.deleteVehicle,
+			},
+		},
+	})
+
+	s, err = graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+	return
+}
+
+// graphQLResolver is an struct that resolves the fields of the graphql schema with the vehicle service.
+type graphQLResolver struct {
+	// sv is the service of the vehicles.
+	sv internal.ServiceVehicle
+}
+
+// errGraphQLStop stops the iteration over the vehicles once the result is known.
+var errGraphQLStop = errors.New("handler: stop iteration")
+
+func (r *graphQLResolver) vehicle(p graphql.ResolveParams) (any, error) {
+	id := p.Args["id"].(int)
+
+	var v *internal.VehicleJSON
+	err := r.sv.ForEach(func(vh internal.Vehicle) (err error) {
+		if vh.ID == id {
+			vj := internal.SerializeVehicle(vh, internal.UnitSystemMetric)
+			v = &vj
+			return errGraphQLStop
+		}
+		return
+	})
+	switch {
+	case err == nil || errors.Is(err, errGraphQLStop) || errors.Is(err, internal.ErrServiceVehiclesNotFound):
+	default:
+		return nil, graphQLError(err)
+	}
+	if v == nil {
+		return nil, graphQLError(internal.ErrServiceVehicleNotFound)
+	}
+	return v, nil
+}
+
+func (r *graphQLResolver) vehicles(p graphql.ResolveParams) (any, error) {
+	filter, _ := p.Args["filter"].(map[string]any)
+	offset, _ := p.Args["offset"].(int)
+	limit, hasLimit := p.Args["limit"].(int)
+	if offset < 0 || (hasLimit && limit < 0) {
+		return nil, &GraphQLError{Message: "invalid pagination", Code: "BAD_USER_INPUT"}
+	}
+
+	total := 0
+	vehicles := make([]internal.VehicleJSON, 0)
+	err := r.sv.ForEach(func(v internal.Vehicle) (err error) {
+		if !matchGraphQLFilter(v, filter) {
+			return
+		}
+		if total >= offset && (!hasLimit || len(vehicles) < limit) {
+			vehicles = append(vehicles, internal.SerializeVehicle(v, internal.UnitSystemMetric))
+		}
+		total++
+		return
+	})
+	if err != nil && !errors.Is(err, internal.ErrServiceVehiclesNotFound) {
+		return nil, graphQLError(err)
+	}
+
+	return map[string]any{"total": total, "vehicles": vehicles}, nil
+}
+
+func (r *graphQLResolver) averageSpeedByBrand(p graphql.ResolveParams) (any, error) {
+	avg, err := r.sv.CalculateAverageSpeedByBrand(p.Args["brand"].(string))
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	return avg, nil
+}
+
+func (r *graphQLResolver) averageCapacityByBrand(p graphql.ResolveParams) (any, error) {
+	avg, err := r.sv.CalculateAverageCapacityByBrand(p.Args["brand"].(string))
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	return avg, nil
+}
+
+func (r *graphQLResolver) createVehicle(p graphql.ResolveParams) (any, error) {
+	v, err := r.sv.Insert(graphQLInputVehicle(p.Args["input"].(map[string]any)))
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	return internal.SerializeVehicle(v, internal.UnitSystemMetric), nil
+}
+
+func (r *graphQLResolver) createVehicles(p graphql.ResolveParams) (any, error) {
+	input := p.Args["input"].([]any)
+	vs := make([]internal.Vehicle, len(input))
+	for i := range input {
+		vs[i] = graphQLInputVehicle(input[i].(map[string]any))
+	}
+
+	nvs, err := r.sv.InsertMany(vs)
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	data := make([]internal.VehicleJSON, len(nvs))
+	for i, v := range nvs {
+		data[i] = internal.SerializeVehicle(v, internal.UnitSystemMetric)
+	}
+	return data, nil
+}
+
+func (r *graphQLResolver) updateMaxSpeed(p graphql.ResolveParams) (any, error) {
+	v, err := r.sv.UpdateMaxSpeedById(p.Args["id"].(int), p.Args["max_speed"].(int))
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	return internal.SerializeVehicle(v, internal.UnitSystemMetric), nil
+}
+
+func (r *graphQLResolver) updateFuelType(p graphql.ResolveParams) (any, error) {
+	f := internal.VehicleFuel{FuelType: p.Args["fuel_type"].(string)}
+	f.BatteryCapacity, _ = p.Args["battery_capacity"].(float64)
+	f.Range, _ = p.Args["range"].(int)
+	f.ChargingConnector, _ = p.Args["charging_connector"].(string)
+	v, err := r.sv.UpdateFuelTypeById(p.Args["id"].(int), f)
+	if err != nil {
+		return nil, graphQLError(err)
+	}
+	return internal.SerializeVehicle(v, internal.UnitSystemMetric), nil
+}
+
+func (r *graphQLResolver) deleteVehicle(p graphql.ResolveParams) (any, error) {
+	id := p.Args["id"].(int)
+	if err := r.sv.Delete(id); err != nil {
+		return nil, graphQLError(err)
+	}
+	return id, nil
+}
+
+// graphQLInputVehicle returns the vehicle of a VehicleInput, whose fields are all non null but the energy ones.
+func graphQLInputVehicle(in map[string]any) internal.Vehicle {
+	v := internal.Vehicle{
+		Attributes: internal.VehicleAttributes{
+			Brand:        in["brand"].(string),
+			Model:        in["model"].(string),
+			Registration: in["registration"].(string),
+			Year:         in["year"].(int),
+			Color:        in["color"].(string),
+			MaxSpeed:     in["max_speed"].(int),
+			FuelType:     in["fuel_type"].(string),
+			Transmission: in["transmission"].(string),
+			Passengers:   in["passengers"].(int),
+			Height:       in["height"].(float64),
+			Width:        in["width"].(float64),
+			Weight:       in["weight"].(float64),
+		},
+	}
+	if b, ok := in["battery_capacity"].(float64); ok {
+		v.Attributes.BatteryCapacity = b
+	}
+	if r, ok := in["range"].(int); ok {
+		v.Attributes.Range = r
+	}
+	if c, ok := in["charging_connector"].(string); ok {
+		v.Attributes.ChargingConnector = c
+	}
+	return v
+}
+
+// matchGraphQLFilter returns true if the vehicle matches every condition of a VehicleFilter.
+func matchGraphQLFilter(v internal.Vehicle, f map[string]any) bool {
+	a := v.Attributes
+	for name, value := range f {
+		if value == nil {
+			continue
+		}
+		var ok bool
+		switch name {
+		case "brand":
+			ok = a.Brand == value.(string)
+		case "model":
+			ok = a.Model == value.(string)
+		case "color":
+			ok = a.Color == value.(string)
+		case "fuel_type":
+			ok = a.FuelType == value.(string)
+		case "transmission":
+			ok = a.Transmission == value.(string)
+		case "charging_connector":
+			ok = a.ChargingConnector == value.(string)
+		case "year_min":
+			ok = a.Year >= value.(int)
+		case "year_max":
+			ok = a.Year <= value.(int)
+		case "max_speed_min":
+			ok = a.MaxSpeed >= value.(int)
+		case "max_speed_max":
+			ok = a.MaxSpeed <= value.(int)
+		case "passengers_min":
+			ok = a.Passengers >= value.(int)
+		case "passengers_max":
+			ok = a.Passengers <= value.(int)
+		case "height_min":
+			ok = a.Height >= value.(float64)
+		case "height_max":
+			ok = a.Height <= value.(float64)
+		case "width_min":
+			ok = a.Width >= value.(float64)
+		case "width_max":
+			ok = a.Width <= value.(float64)
+		case "weight_min":
+			ok = a.Weight >= value.(float64)
+		case "weight_max":
+			ok = a.Weight <= value.(float64)
+		case "range_min":
+			ok = a.BatteryCapacity > 0 && a.Range >= value.(int)
+		case "range_max":
+			ok = a.BatteryCapacity > 0 && a.Range <= value.(int)
+		default:
+			ok = true
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+)
+
+// graphQLDo runs a request against the schema.
+func graphQLDo(t *testing.T, s graphql.Schema, query string, variables map[string]any) *graphql.Result {
+	t.Helper()
+	return graphql.Do(graphql.Params{Schema: s, RequestString: query, VariableValues: variables, Context: context.Background()})
+}
+
+// graphQLData returns the data of a result decoded into v, failing the test if the result has errors.
+func graphQLData(t *testing.T, r *graphql.Result, v any) {
+	t.Helper()
+	if r.HasErrors() {
+		t.Fatalf("errors = %v", r.Errors)
+	}
+	b, err := json.Marshal(r.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestGraphQLSchema(t *testing.T) graphql.Schema {
+	t.Helper()
+	s, err := NewGraphQLSchema(newTestService())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestGraphQLSchema_Vehicle(t *testing.T) {
+	s := newTestGraphQLSchema(t)
+
+	var data struct {
+		Vehicle struct {
+			ID                int     `json:"id"`
+			Model             string  `json:"model"`
+			BatteryCapacity   float64 `json:"battery_capacity"`
+			ChargingConnector string  `json:"charging_connector"`
+			LengthUnit        string  `json:"length_unit"`
+		} `json:"vehicle"`
+	}
+	r := graphQLDo(t, s, `query($id: Int!) { vehicle(id: $id) { id model battery_capacity charging_connector length_unit } }`, map[string]any{"id": 3})
+	graphQLData(t, r, &data)
+	if v := data.Vehicle; v.ID != 3 || v.Model != "Leaf" || v.BatteryCapacity != 40 || v.ChargingConnector != "CHAdeMO" || v.LengthUnit != "cm" {
+		t.Errorf("vehicle = %+v", v)
+	}
+}
+
+func TestGraphQLSchema_VehicleNotFound(t *testing.T) {
+	s := newTestGraphQLSchema(t)
+
+	r := graphQLDo(t, s, `{ vehicle(id: 99) { id } }`, nil)
+	if len(r.Errors) != 1 {
+		t.Fatalf("errors = %v, want one", r.Errors)
+	}
+	if r.Errors[0].Message != "vehicle not found" || r.Errors[0].Extensions["code"] != "NOT_FOUND" ||
+		r.Errors[0].Extensions["error_code"] != "vehicle_not_found" {
+		t.Errorf("error = %+v", r.Errors[0])
+	}
+}
+
+func TestGraphQLSchema_Vehicles(t *testing.T) {
+	s := newTestGraphQLSchema(t)
+
+	tests := []struct {
+		name  string
+		query string
+		total int
+		ids   []int
+	}{
+		{"all", `{ vehicles { total vehicles { id } } }`, 3, []int{1, 2, 3}},
+		{"filter", `{ vehicles(filter: {brand: "Ford", year_min: 2016}) { total vehicles { id } } }`, 1, []int{2}},
+		{"range", `{ vehicles(filter: {range_min: 100}) { total vehicles { id } } }`, 1, []int{3}},
+		{"page", `{ vehicles(limit: 1, offset: 1) { total vehicles { id } } }`, 3, []int{2}},
+		{"none", `{ vehicles(filter: {color: "Black"}) { total vehicles { id } } }`, 0, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data struct {
+				Vehicles struct {
+					Total    int `json:"total"`
+					Vehicles []struct {
+						ID int `json:"id"`
+					} `json:"vehicles"`
+				} `json:"vehicles"`
+			}
+			graphQLData(t, graphQLDo(t, s, tt.query, nil), &data)
+			ids := make([]int, len(data.Vehicles.Vehicles))
+			for i, v := range data.Vehicles.Vehicles {
+				ids[i] = v.ID
+			}
+			if data.Vehicles.Total != tt.total || !slices.Equal(ids, tt.ids) {
+				t.Errorf("total = %d, ids = %v, want %d, %v", data.Vehicles.Total, ids, tt.total, tt.ids)
+			}
+		})
+	}
+}
+
+func TestGraphQLSchema_Averages(t *testing.T) {
+	s := newTestGraphQLSchema(t)
+
+	var data struct {
+		Speed    float64 `json:"average_speed_by_brand"`
+		Capacity float64 `json:"average_capacity_by_brand"`
+	}
+	graphQLData(t, graphQLDo(t, s, `{ average_speed_by_brand(brand: "Ford") average_capacity_by_brand(brand: "Ford") }`, nil), &data)
+	if data.Speed != 225 || data.Capacity != 4.5 {
+		t.Errorf("averages = %+v, want 225 and 4.5", data)
+	}
+
+	r := graphQLDo(t, s, `{ average_speed_by_brand(brand: "Tesla") }`, nil)
+	if len(r.Errors) != 1 || r.Errors[0].Extensions["code"] != "NOT_FOUND" {
+		t.Errorf("errors = %v, want NOT_FOUND", r.Errors)
+	}
+}
+
+func TestGraphQLSchema_Mutations(t *testing.T) {
+	s := newTestGraphQLSchema(t)
+	input := map[string]any{
+		"brand": "Toyota", "model": "Prius", "registration": "DDD444", "year": 2019, "color": "Silver",
+		"max_speed": 180, "fuel_type": "hybrid", "transmission": "automatic", "passengers": 5,
+		"height": 149, "width": 176, "weight": 1400, "battery_capacity": 1.3,
+	}
+
+	// create
+	var created struct {
+		Vehicle struct {
+			ID       int    `json:"id"`
+			FuelType string `json:"fuel_type"`
+		} `json:"create_vehicle"`
+	}
+	graphQLData(t, graphQLDo(t, s, `mutation($input: VehicleInput!) { create_vehicle(input: $input) { id fuel_type } }`, map[string]any{"input": input}), &created)
+	if created.Vehicle.ID != 4 || created.Vehicle.FuelType != "hybrid" {
+		t.Errorf("created = %+v", created.Vehicle)
+	}
+
+	// update
+	var updated struct {
+		Vehicle struct {
+			MaxSpeed int `json:"max_speed"`
+		} `json:"update_max_speed"`
+	}
+	graphQLData(t, graphQLDo(t, s, `mutation { update_max_speed(id: 4, max_speed: 190) { max_speed } }`, nil), &updated)
+	if updated.Vehicle.MaxSpeed != 190 {
+		t.Errorf("max speed = %d, want 190", updated.Vehicle.MaxSpeed)
+	}
+
+	// delete
+	var deleted struct {
+		ID int `json:"delete_vehicle"`
+	}
+	graphQLData(t, graphQLDo(t, s, `mutation { delete_vehicle(id: 4) }`, nil), &deleted)
+	if deleted.ID != 4 {
+		t.Errorf("deleted = %d, want 4", deleted.ID)
+	}
+	r := graphQLDo(t, s, `{ vehicle(id: 4) { id } }`, nil)
+	if len(r.Errors) != 1 || r.Errors[0].Extensions["code"] != "NOT_FOUND" {
+		t.Errorf("errors = %v, want NOT_FOUND", r.Errors)
+	}
+
+	// invalid input
+	input["battery_capacity"] = 0.0
+	r = graphQLDo(t, s, `mutation($input: VehicleInput!) { create_vehicle(input: $input) { id } }`, map[string]any{"input": input})
+	if len(r.Errors) != 1 || r.Errors[0].Message != "invalid vehicle battery capacity" || r.Errors[0].Extensions["code"] != "BAD_USER_INPUT" {
+		t.Errorf("errors = %v, want BAD_USER_INPUT", r.Errors)
+	}
+}
+
+func TestGraphQLDefault_Query(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hd, err := NewGraphQLDefault(newTestService())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := gin.New()
+	rt.GET("/graphql", hd.Query())
+	rt.POST("/graphql", hd.Query())
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		code   int
+		want   string
+	}{
+		{"get", http.MethodGet, "/graphql?query=" + "%7B%20vehicle(id%3A%201)%20%7B%20model%20%7D%20%7D", "", http.StatusOK, `{"data":{"vehicle":{"model":"Focus"}}}`},
+		{"post", http.MethodPost, "/graphql", `{"query": "query($id: Int!) { vehicle(id: $id) { model } }", "variables": {"id": 2}}`, http.StatusOK, `{"data":{"vehicle":{"model":"Mustang"}}}`},
+		{"mutation with get", http.MethodGet, "/graphql?query=" + "mutation%20%7B%20delete_vehicle(id%3A%201)%20%7D", "", http.StatusMethodNotAllowed, `{"code":"method_not_allowed","error":"mutations are only allowed with POST"}`},
+		{"without query", http.MethodGet, "/graphql", "", http.StatusBadRequest, `{"code":"invalid_graphql_query","error":"query is required"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			res := httptest.NewRecorder()
+			rt.ServeHTTP(res, req)
+
+			if res.Code != tt.code || res.Body.String() != tt.want {
+				t.Errorf("response = %d %s, want %d %s", res.Code, res.Body.String(), tt.code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"app/internal"
+	"app/internal/eventbus"
+	"app/internal/repository"
+	"app/internal/service"
+)
+
+// testVehicles are the vehicles the services of the tests start with.
+func testVehicles() []internal.Vehicle {
+	return []internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{
+			Brand: "Ford", Model: "Focus", Registration: "AAA111", Year: 2015, Color: "Blue", MaxSpeed: 200,
+			FuelType: "gasoline", Transmission: "manual", Passengers: 5, Height: 150, Width: 180, Weight: 1300,
+		}, Source: "vehicles.json"},
+		{ID: 2, Attributes: internal.VehicleAttributes{
+			Brand: "Ford", Model: "Mustang", Registration: "BBB222", Year: 2020, Color: "Red", MaxSpeed: 250,
+			FuelType: "gasoline", Transmission: "automatic", Passengers: 4, Height: 140, Width: 190, Weight: 1700,
+		}},
+		{ID: 3, Attributes: internal.VehicleAttributes{
+			Brand: "Nissan", Model: "Leaf", Registration: "CCC333", Year: 2021, Color: "White", MaxSpeed: 150,
+			FuelType: "electric", Transmission: "automatic", Passengers: 5, Height: 155, Width: 177, Weight: 1500,
+			BatteryCapacity: 40, Range: 270, ChargingConnector: "CHAdeMO",
+		}},
+	}
+}
+
+// newTestService returns a vehicle service in memory with the test vehicles.
+func newTestService() internal.ServiceVehicle {
+	vehicles := testVehicles()
+	rp := repository.NewVehicleSlice(vehicles, len(vehicles))
+	bus := eventbus.NewVehicleMemory(64)
+	sr := service.NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	return service.NewDefault(rp, bus, sr, repository.NewOwnerMap())
+}
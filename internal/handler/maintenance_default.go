@@ -0,0 +1,405 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maintenanceDateLayout is the layout of the dates of the maintenance records.
+const maintenanceDateLayout = time.DateOnly
+
+// MaintenanceRecordJSON is an struct that represents a maintenance record in json format.
+type MaintenanceRecordJSON struct {
+	ID        int       `json:"id"`
+	VehicleID int       `json:"vehicle_id"`
+	Date      string    `json:"date"`
+	Odometer  int       `json:"odometer"`
+	Type      string    `json:"type"`
+	Cost      float64   `json:"cost"`
+	Notes     string    `json:"notes,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BodyRequestMaintenance is an struct that represents the body to create or update a maintenance record.
+type BodyRequestMaintenance struct {
+	Date     *string  `json:"date" request:"required"`
+	Odometer *int     `json:"odometer" request:"required"`
+	Type     *string  `json:"type" request:"required"`
+	Cost     *float64 `json:"cost"`
+	Notes    *string  `json:"notes"`
+}
+
+// attributes returns the maintenance attributes of the body, it must have been decoded with every required field.
+func (b BodyRequestMaintenance) attributes() (a internal.MaintenanceAttributes, err error) {
+	a.Date, err = time.Parse(maintenanceDateLayout, *b.Date)
+	if err != nil {
+		err = internal.ErrServiceInvalidMaintenanceDate
+		return
+	}
+	a.Odometer = *b.Odometer
+	a.Type = internal.MaintenanceType(*b.Type)
+	if b.Cost != nil {
+		a.Cost = *b.Cost
+	}
+	if b.Notes != nil {
+		a.Notes = *b.Notes
+	}
+	return
+}
+
+// MaintenanceIntervalJSON is an struct that represents a maintenance interval in json format.
+type MaintenanceIntervalJSON struct {
+	Brand      string `json:"brand,omitempty"`
+	FuelType   string `json:"fuel_type,omitempty"`
+	Type       string `json:"type"`
+	Months     int    `json:"months"`
+	Kilometers int    `json:"kilometers"`
+}
+
+// BodyRequestMaintenanceInterval is an struct that represents an interval of the body to replace the intervals.
+type BodyRequestMaintenanceInterval struct {
+	Brand      *string `json:"brand"`
+	FuelType   *string `json:"fuel_type"`
+	Type       *string `json:"type" request:"required"`
+	Months     *int    `json:"months"`
+	Kilometers *int    `json:"kilometers"`
+}
+
+// interval returns the maintenance interval of the body, it must have been decoded with every required field.
+func (b BodyRequestMaintenanceInterval) interval() internal.MaintenanceInterval {
+	i := internal.MaintenanceInterval{Type: internal.MaintenanceType(*b.Type)}
+	if b.Brand != nil {
+		i.Brand = *b.Brand
+	}
+	if b.FuelType != nil {
+		i.FuelType = *b.FuelType
+	}
+	if b.Months != nil {
+		i.Months = *b.Months
+	}
+	if b.Kilometers != nil {
+		i.Kilometers = *b.Kilometers
+	}
+	return i
+}
+
+// MaintenanceDueJSON is an struct that represents a maintenance a vehicle needs in json format.
+// The fields of the vehicle are not nested so the report can be written as flat records.
+type MaintenanceDueJSON struct {
+	VehicleID          int    `json:"vehicle_id" xml:"vehicle_id" yaml:"vehicle_id"`
+	Brand              string `json:"brand" xml:"brand" yaml:"brand"`
+	Model              string `json:"model" xml:"model" yaml:"model"`
+	Registration       string `json:"registration" xml:"registration" yaml:"registration"`
+	FuelType           string `json:"fuel_type" xml:"fuel_type" yaml:"fuel_type"`
+	Type               string `json:"type" xml:"type" yaml:"type"`
+	IntervalMonths     int    `json:"interval_months,omitempty" xml:"interval_months,omitempty" yaml:"interval_months,omitempty"`
+	IntervalKilometers int    `json:"interval_kilometers,omitempty" xml:"interval_kilometers,omitempty" yaml:"interval_kilometers,omitempty"`
+	LastDate           string `json:"last_date,omitempty" xml:"last_date,omitempty" yaml:"last_date,omitempty"`
+	LastOdometer       int    `json:"last_odometer,omitempty" xml:"last_odometer,omitempty" yaml:"last_odometer,omitempty"`
+	Odometer           int    `json:"odometer" xml:"odometer" yaml:"odometer"`
+	DueDate            string `json:"due_date,omitempty" xml:"due_date,omitempty" yaml:"due_date,omitempty"`
+	DueOdometer        int    `json:"due_odometer,omitempty" xml:"due_odometer,omitempty" yaml:"due_odometer,omitempty"`
+	Overdue            bool   `json:"overdue" xml:"overdue" yaml:"overdue"`
+}
+
+// serializeMaintenanceRecord returns the json representation of a maintenance record.
+func serializeMaintenanceRecord(r internal.MaintenanceRecord) MaintenanceRecordJSON {
+	return MaintenanceRecordJSON{
+		ID:        r.ID,
+		VehicleID: r.VehicleID,
+		Date:      r.Attributes.Date.Format(maintenanceDateLayout),
+		Odometer:  r.Attributes.Odometer,
+		Type:      string(r.Attributes.Type),
+		Cost:      r.Attributes.Cost,
+		Notes:     r.Attributes.Notes,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// serializeMaintenanceIntervals returns the json representation of maintenance intervals.
+func serializeMaintenanceIntervals(i []internal.MaintenanceInterval) []MaintenanceIntervalJSON {
+	ij := make([]MaintenanceIntervalJSON, len(i))
+	for n, in := range i {
+		ij[n] = MaintenanceIntervalJSON{
+			Brand:      in.Brand,
+			FuelType:   in.FuelType,
+			Type:       string(in.Type),
+			Months:     in.Months,
+			Kilometers: in.Kilometers,
+		}
+	}
+	return ij
+}
+
+// serializeMaintenanceDue returns the json representation of a maintenance a vehicle needs.
+func serializeMaintenanceDue(d internal.MaintenanceDue) MaintenanceDueJSON {
+	dj := MaintenanceDueJSON{
+		VehicleID:          d.Vehicle.ID,
+		Brand:              d.Vehicle.Attributes.Brand,
+		Model:              d.Vehicle.Attributes.Model,
+		Registration:       d.Vehicle.Attributes.Registration,
+		FuelType:           d.Vehicle.Attributes.FuelType,
+		Type:               string(d.Interval.Type),
+		IntervalMonths:     d.Interval.Months,
+		IntervalKilometers: d.Interval.Kilometers,
+		Odometer:           d.Odometer,
+		DueOdometer:        d.DueOdometer,
+		Overdue:            d.Overdue,
+	}
+	if d.Last != nil {
+		dj.LastDate = d.Last.Attributes.Date.Format(maintenanceDateLayout)
+		dj.LastOdometer = d.Last.Attributes.Odometer
+	}
+	if !d.DueDate.IsZero() {
+		dj.DueDate = d.DueDate.Format(maintenanceDateLayout)
+	}
+	return dj
+}
+
+// NewMaintenanceDefault returns a new instance of a maintenance handler.
+// The due report is written with the encoder negotiated with the Accept header, the default encoders if enc is nil.
+func NewMaintenanceDefault(sv internal.ServiceMaintenance, enc *Encoders) *MaintenanceDefault {
+	if enc == nil {
+		enc = DefaultEncoders()
+	}
+	return &MaintenanceDefault{sv: sv, enc: enc}
+}
+
+// MaintenanceDefault is an struct that contains handlers for the maintenance of the vehicles.
+type MaintenanceDefault struct {
+	sv internal.ServiceMaintenance
+	// enc are the encoders the due report can be written with.
+	enc *Encoders
+}
+
+// GetAll returns the maintenance records of a vehicle, of the kind of the type query param if it is given.
+func (hd *MaintenanceDefault) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		vehicleId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		records, err := hd.sv.FindByVehicle(vehicleId, internal.MaintenanceType(ctx.Query("type")))
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		data := make([]MaintenanceRecordJSON, len(records))
+		for i, r := range records {
+			data[i] = serializeMaintenanceRecord(r)
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "maintenance records found", "data": data})
+	}
+}
+
+// Get returns a maintenance record of a vehicle.
+func (hd *MaintenanceDefault) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		vehicleId, id, ok := maintenanceIds(ctx)
+		if !ok {
+			return
+		}
+
+		r, err := hd.sv.FindById(vehicleId, id)
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "maintenance record found", "data": serializeMaintenanceRecord(r)})
+	}
+}
+
+// Create creates a maintenance record of a vehicle.
+func (hd *MaintenanceDefault) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		vehicleId, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		var body BodyRequestMaintenance
+		if !bindStrict(ctx, &body) {
+			return
+		}
+		a, err := body.attributes()
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		r, err := hd.sv.Insert(vehicleId, a)
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, gin.H{"message": "maintenance record created", "data": serializeMaintenanceRecord(r)})
+	}
+}
+
+// Update replaces a maintenance record of a vehicle.
+func (hd *MaintenanceDefault) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		vehicleId, id, ok := maintenanceIds(ctx)
+		if !ok {
+			return
+		}
+		var body BodyRequestMaintenance
+		if !bindStrict(ctx, &body) {
+			return
+		}
+		a, err := body.attributes()
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		r, err := hd.sv.Update(vehicleId, id, a)
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "maintenance record updated", "data": serializeMaintenanceRecord(r)})
+	}
+}
+
+// Delete removes a maintenance record of a vehicle.
+func (hd *MaintenanceDefault) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		vehicleId, id, ok := maintenanceIds(ctx)
+		if !ok {
+			return
+		}
+
+		if err := hd.sv.Delete(vehicleId, id); err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// GetIntervals returns the intervals the maintenance is due at.
+func (hd *MaintenanceDefault) GetIntervals() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		intervals, err := hd.sv.FindIntervals()
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "maintenance intervals found", "data": serializeMaintenanceIntervals(intervals)})
+	}
+}
+
+// UpdateIntervals replaces all the intervals the maintenance is due at with the ones of the body.
+func (hd *MaintenanceDefault) UpdateIntervals() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var body []BodyRequestMaintenanceInterval
+		if !bindStrictSlice(ctx, &body) {
+			return
+		}
+		intervals := make([]internal.MaintenanceInterval, len(body))
+		for i, b := range body {
+			intervals[i] = b.interval()
+		}
+
+		intervals, err := hd.sv.ReplaceIntervals(intervals)
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "maintenance intervals updated", "data": serializeMaintenanceIntervals(intervals)})
+	}
+}
+
+// GetDue returns the maintenance the vehicles need on the date query param (today by default),
+// and the one due within the days query param after it.
+func (hd *MaintenanceDefault) GetDue() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		q := internal.MaintenanceDueQuery{Type: internal.MaintenanceType(ctx.Query("type"))}
+		if s := ctx.Query("date"); s != "" {
+			date, err := time.Parse(maintenanceDateLayout, s)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid date", "code": "invalid_date"})
+				return
+			}
+			q.Date = date
+		}
+		if s := ctx.Query("days"); s != "" {
+			days, err := strconv.Atoi(s)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid days", "code": "invalid_maintenance_due_days"})
+				return
+			}
+			q.Days = days
+		}
+
+		due, err := hd.sv.FindAllDue(q)
+		if err != nil {
+			maintenanceErrorResponse(ctx, err)
+			return
+		}
+
+		data := make([]MaintenanceDueJSON, len(due))
+		for i, d := range due {
+			data[i] = serializeMaintenanceDue(d)
+		}
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "maintenance due found",
+			Data:    data,
+		})
+	}
+}
+
+// maintenanceIds returns the vehicle and record identifiers of the params, writing a bad request response if invalid.
+func maintenanceIds(ctx *gin.Context) (vehicleId int, id int, ok bool) {
+	vehicleId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+		return
+	}
+	id, err = strconv.Atoi(ctx.Param("record_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid record identifier", "code": "invalid_record_identifier"})
+		return
+	}
+	ok = true
+	return
+}
+
+// maintenanceErrorResponse writes the response for an error of the maintenance service.
+func maintenanceErrorResponse(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, internal.ErrServiceInvalidMaintenanceDate):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid maintenance date", "code": "invalid_maintenance_date"})
+	case errors.Is(err, internal.ErrServiceInvalidMaintenanceOdometer):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid maintenance odometer", "code": "invalid_maintenance_odometer"})
+	case errors.Is(err, internal.ErrServiceInvalidMaintenanceType):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid maintenance type", "code": "invalid_maintenance_type"})
+	case errors.Is(err, internal.ErrServiceInvalidMaintenanceCost):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid maintenance cost", "code": "invalid_maintenance_cost"})
+	case errors.Is(err, internal.ErrServiceInvalidMaintenanceIntervals):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid maintenance intervals", "code": "invalid_maintenance_intervals"})
+	case errors.Is(err, internal.ErrServiceInvalidMaintenanceDueDays):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid days", "code": "invalid_maintenance_due_days"})
+	case errors.Is(err, internal.ErrServiceVehicleNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found", "code": "vehicle_not_found"})
+	case errors.Is(err, internal.ErrServiceMaintenanceNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "maintenance record not found", "code": "maintenance_not_found"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+	}
+}
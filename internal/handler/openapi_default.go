@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// swaggerUI is the page that renders the document with Swagger UI.
+const swaggerUI = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Vehicles API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => { window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" }); };
+  </script>
+</body>
+</html>
+`
+
+// NewOpenAPIDefault returns a new instance of a handler for the OpenAPI document.
+func NewOpenAPIDefault(doc []byte) *OpenAPIDefault {
+	return &OpenAPIDefault{doc: doc}
+}
+
+// OpenAPIDefault is an struct that contains handlers for the OpenAPI document.
+type OpenAPIDefault struct {
+	// doc is the document in json.
+	doc []byte
+}
+
+// Document returns the OpenAPI document.
+func (hd *OpenAPIDefault) Document() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/json; charset=utf-8", hd.doc)
+	}
+}
+
+// UI returns the Swagger UI page for the document.
+func (hd *OpenAPIDefault) UI() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUI))
+	}
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OwnerJSON is an struct that represents an owner in json format.
+type OwnerJSON struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BodyRequestOwner is an struct that represents the body to create or update an owner.
+type BodyRequestOwner struct {
+	Name  *string `json:"name" request:"required"`
+	Email *string `json:"email" request:"required"`
+	Phone *string `json:"phone"`
+}
+
+// attributes returns the owner attributes of the body, it must have been decoded with every required field.
+func (b BodyRequestOwner) attributes() internal.OwnerAttributes {
+	a := internal.OwnerAttributes{Name: *b.Name, Email: *b.Email}
+	if b.Phone != nil {
+		a.Phone = *b.Phone
+	}
+	return a
+}
+
+// serializeOwner returns the json representation of an owner.
+func serializeOwner(o internal.Owner) OwnerJSON {
+	return OwnerJSON{
+		ID:        o.ID,
+		Name:      o.Attributes.Name,
+		Email:     o.Attributes.Email,
+		Phone:     o.Attributes.Phone,
+		CreatedAt: o.CreatedAt,
+	}
+}
+
+// NewOwnerDefault returns a new instance of an owner handler.
+func NewOwnerDefault(sv internal.ServiceOwner) *OwnerDefault {
+	return &OwnerDefault{sv: sv}
+}
+
+// OwnerDefault is an struct that contains handlers for owners.
+type OwnerDefault struct {
+	sv internal.ServiceOwner
+}
+
+// GetAll returns all owners.
+func (hd *OwnerDefault) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		owners, err := hd.sv.FindAll()
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			return
+		}
+
+		data := make([]OwnerJSON, len(owners))
+		for i, o := range owners {
+			data[i] = serializeOwner(o)
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "owners found", "data": data})
+	}
+}
+
+// Get returns an owner.
+func (hd *OwnerDefault) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		o, err := hd.sv.FindById(id)
+		if err != nil {
+			ownerErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "owner found", "data": serializeOwner(o)})
+	}
+}
+
+// Create creates an owner.
+func (hd *OwnerDefault) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var body BodyRequestOwner
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		o, err := hd.sv.Insert(body.attributes())
+		if err != nil {
+			ownerErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, gin.H{"message": "owner created", "data": serializeOwner(o)})
+	}
+}
+
+// Update replaces an owner.
+func (hd *OwnerDefault) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		var body BodyRequestOwner
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		o, err := hd.sv.Update(id, body.attributes())
+		if err != nil {
+			ownerErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "owner updated", "data": serializeOwner(o)})
+	}
+}
+
+// Delete removes an owner, the vehicles of the owner have to be given another owner (or none) first.
+func (hd *OwnerDefault) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		if err := hd.sv.Delete(id); err != nil {
+			ownerErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// GetVehicles returns the vehicles of an owner, with the dimensions and weight in the units query param.
+func (hd *OwnerDefault) GetVehicles() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		vehicles, err := hd.sv.FindVehicles(id)
+		if err != nil {
+			ownerErrorResponse(ctx, err)
+			return
+		}
+
+		data := make([]internal.VehicleJSON, len(vehicles))
+		for i, v := range vehicles {
+			data[i] = internal.SerializeVehicle(v, us)
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "vehicles of the owner found", "data": data})
+	}
+}
+
+// ownerErrorResponse writes the response for an error of the owner service.
+func ownerErrorResponse(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, internal.ErrServiceInvalidOwnerName):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid owner name", "code": "invalid_owner_name"})
+	case errors.Is(err, internal.ErrServiceInvalidOwnerEmail):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid owner email", "code": "invalid_owner_email"})
+	case errors.Is(err, internal.ErrServiceInvalidOwnerPhone):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid owner phone", "code": "invalid_owner_phone"})
+	case errors.Is(err, internal.ErrServiceOwnerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "owner not found", "code": "owner_not_found"})
+	case errors.Is(err, internal.ErrServiceOwnerHasVehicles):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "owner has vehicles", "code": "owner_has_vehicles"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+	}
+}
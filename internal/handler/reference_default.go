@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodyRequestReference is an struct that represents the body to add a value to a vocabulary.
+type BodyRequestReference struct {
+	Value *string `json:"value" request:"required"`
+}
+
+// NewReferenceDefault returns a new instance of a handler of controlled vocabularies.
+func NewReferenceDefault(sv internal.ServiceReference) *ReferenceDefault {
+	return &ReferenceDefault{sv: sv}
+}
+
+// ReferenceDefault is an struct that contains handlers for the controlled vocabularies
+// (fuel-types, transmissions, colors and brands), given in the kind path param.
+type ReferenceDefault struct {
+	sv internal.ServiceReference
+}
+
+// GetAll returns the values of a vocabulary.
+func (hd *ReferenceDefault) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		values, err := hd.sv.FindAll(internal.ReferenceKind(ctx.Param("kind")))
+		if err != nil {
+			referenceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "reference values found", "data": values})
+	}
+}
+
+// Create adds a value to a vocabulary, so vehicles can have it.
+func (hd *ReferenceDefault) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var body BodyRequestReference
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		value, err := hd.sv.Insert(internal.ReferenceKind(ctx.Param("kind")), *body.Value)
+		if err != nil {
+			referenceErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, gin.H{"message": "reference value created", "data": value})
+	}
+}
+
+// referenceErrorResponse writes the response of an error of the reference service.
+func referenceErrorResponse(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, internal.ErrServiceInvalidReferenceValue):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid reference value", "code": "invalid_reference_value"})
+	case errors.Is(err, internal.ErrServiceReferenceKindNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "reference kind not found", "code": "reference_kind_not_found"})
+	case errors.Is(err, internal.ErrServiceReferenceAlreadyExists):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "reference value already exists", "code": "reference_already_exists"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+	}
+}
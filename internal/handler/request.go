@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"app/internal"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodyRequestVehicle is an struct that represents the body to create a vehicle.
+// Fields are pointers so missing ones can be told apart from zero values.
+type BodyRequestVehicle struct {
+	Brand        *string  `json:"brand" request:"required"`
+	Model        *string  `json:"model" request:"required"`
+	Registration *string  `json:"registration" request:"required"`
+	Year         *int     `json:"year" request:"required"`
+	Color        *string  `json:"color" request:"required"`
+	MaxSpeed     *int     `json:"max_speed" request:"required"`
+	FuelType     *string  `json:"fuel_type" request:"required"`
+	Transmission *string  `json:"transmission" request:"required"`
+	Passengers   *int     `json:"passengers" request:"required"`
+	Height       *float64 `json:"height" request:"required"`
+	Width        *float64 `json:"width" request:"required"`
+	Weight       *float64 `json:"weight" request:"required"`
+	// BatteryCapacity, Range and ChargingConnector are required by the fuel type, not by the body.
+	BatteryCapacity   *float64 `json:"battery_capacity"`
+	Range             *int     `json:"range"`
+	ChargingConnector *string  `json:"charging_connector"`
+	// OwnerID is the owner of the vehicle, which must exist, none if it is missing.
+	OwnerID *int `json:"owner_id"`
+}
+
+// vehicle returns the vehicle of the body, it must have been decoded with every required field.
+// The dimensions and weight of the body are in the units of us, converted to the ones the vehicles are stored in.
+func (b BodyRequestVehicle) vehicle(us internal.UnitSystem) internal.Vehicle {
+	v := internal.Vehicle{
+		Attributes: internal.VehicleAttributes{
+			Brand:        *b.Brand,
+			Model:        *b.Model,
+			Registration: *b.Registration,
+			Year:         *b.Year,
+			Color:        *b.Color,
+			MaxSpeed:     *b.MaxSpeed,
+			FuelType:     *b.FuelType,
+			Transmission: *b.Transmission,
+			Passengers:   *b.Passengers,
+			Height:       storedLength(*b.Height, us.Length()),
+			Width:        storedLength(*b.Width, us.Length()),
+			Weight:       storedWeight(*b.Weight, us.Weight()),
+		},
+	}
+	if b.BatteryCapacity != nil {
+		v.Attributes.BatteryCapacity = *b.BatteryCapacity
+	}
+	if b.Range != nil {
+		v.Attributes.Range = *b.Range
+	}
+	if b.ChargingConnector != nil {
+		v.Attributes.ChargingConnector = *b.ChargingConnector
+	}
+	if b.OwnerID != nil {
+		v.OwnerID = *b.OwnerID
+	}
+	return v
+}
+
+// storedLength returns a length in the unit from in the unit of the vehicles, rounded to hundredths as the stored ones.
+func storedLength(v float64, from internal.LengthUnit) float64 {
+	if from == internal.VehicleLengthUnit {
+		return v
+	}
+	return math.Round(from.Convert(v, internal.VehicleLengthUnit)*100) / 100
+}
+
+// storedWeight returns a weight in the unit from in the unit of the vehicles, rounded to hundredths as the stored ones.
+func storedWeight(v float64, from internal.WeightUnit) float64 {
+	if from == internal.VehicleWeightUnit {
+		return v
+	}
+	return math.Round(from.Convert(v, internal.VehicleWeightUnit)*100) / 100
+}
+
+// unitSystem returns the unit system of the units query param (metric if it is empty),
+// writing a bad request response if it is not valid.
+func unitSystem(ctx *gin.Context) (us internal.UnitSystem, ok bool) {
+	us = internal.UnitSystem(ctx.DefaultQuery("units", string(internal.UnitSystemMetric)))
+	if !us.Valid() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid units", "code": "invalid_units"})
+		return
+	}
+	return us, true
+}
+
+// queryInterval returns the interval of the key query param, written as in internal.ParseInterval
+// (e.g. [100,200), >=100 or 100-200) with each bound read with parse. If minMax and the param is missing,
+// the interval is given by the min and max query params instead, both included.
+// Missing params leave the interval open-ended. It writes a bad request response naming the invalid param,
+// with the code of the attribute of the interval (e.g. invalid_vehicle_year for the years param).
+func queryInterval(ctx *gin.Context, key string, minMax bool, parse func(s string) (float64, error)) (in internal.Interval, ok bool) {
+	code := "invalid_vehicle_" + strings.TrimSuffix(key, "s")
+	if s, set := ctx.GetQuery(key); set || !minMax {
+		if set {
+			var err error
+			if in, err = internal.ParseInterval(s, parse); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + key, "code": code})
+				return
+			}
+		}
+		return in, true
+	}
+
+	for _, b := range []struct {
+		key   string
+		bound **internal.Bound
+	}{{"min", &in.Min}, {"max", &in.Max}} {
+		s, set := ctx.GetQuery(b.key)
+		if !set {
+			continue
+		}
+		v, err := parse(s)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + b.key + " " + key, "code": code})
+			return
+		}
+		*b.bound = &internal.Bound{Value: v, Inclusive: true}
+	}
+	return in, true
+}
+
+// parseNumber returns the number of a bound of an interval without units.
+func parseNumber(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// parseInteger reads the bounds of an interval of whole numbers.
+func parseInteger(s string) (float64, error) {
+	n, err := strconv.Atoi(s)
+	return float64(n), err
+}
+
+// FieldErrors is a map of the invalid fields of a request body to what is wrong with them.
+type FieldErrors map[string]string
+
+// errInvalidBody is returned when the body is not a json object (or array of objects).
+var errInvalidBody = errors.New("handler: invalid request body")
+
+// decodeStrict decodes a json object into v, a pointer to a struct.
+// Unknown fields, values of the wrong type and missing required fields (tagged request:"required")
+// are all reported in fe, keyed by the json name of the field preceded by prefix.
+// Nested structs are decoded the same way (see decodeValue), maps accept any keys.
+func decodeStrict(b []byte, v any, prefix string) (fe FieldErrors, err error) {
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(b, &raw); err != nil || raw == nil {
+		err = errInvalidBody
+		return
+	}
+
+	fe = make(FieldErrors)
+	rv := reflect.ValueOf(v).Elem()
+	rt := rv.Type()
+	known := make(map[string]bool)
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		known[name] = true
+
+		value, ok := raw[name]
+		if !ok || string(value) == "null" {
+			if f.Tag.Get("request") == "required" {
+				fe[prefix+name] = "is required"
+			}
+			continue
+		}
+		decodeValue(value, rv.Field(i), prefix+name, fe)
+	}
+	for name := range raw {
+		if !known[name] {
+			fe[prefix+name] = "is not a known field"
+		}
+	}
+
+	return
+}
+
+// decodeValue decodes a json value into v, the addressable value of the field named name, reporting its errors in fe.
+// Structs (or pointers to them) are decoded with decodeStrict, their errors keyed as name.field,
+// and so are the items of slices of structs, keyed as name[index].field.
+func decodeValue(b json.RawMessage, v reflect.Value, name string, fe FieldErrors) {
+	t := v.Type()
+	switch {
+	case strictStruct(t):
+		if t.Kind() == reflect.Pointer {
+			v.Set(reflect.New(t.Elem()))
+			v = v.Elem()
+		}
+		nested, err := decodeStrict(b, v.Addr().Interface(), name+".")
+		if err != nil {
+			fe[name] = "must be an object"
+			return
+		}
+		for k, msg := range nested {
+			fe[k] = msg
+		}
+	case t.Kind() == reflect.Slice && strictStruct(t.Elem()):
+		var items []json.RawMessage
+		if err := json.Unmarshal(b, &items); err != nil {
+			fe[name] = "must be " + jsonTypeName(t)
+			return
+		}
+		v.Set(reflect.MakeSlice(t, len(items), len(items)))
+		for i, item := range items {
+			decodeValue(item, v.Index(i), fmt.Sprintf("%s[%d]", name, i), fe)
+		}
+	default:
+		if err := json.Unmarshal(b, v.Addr().Interface()); err != nil {
+			fe[name] = "must be " + jsonTypeName(t)
+		}
+	}
+}
+
+// strictStruct returns true if the values of the type are structs (or pointers to them) decoded by decodeStrict,
+// the ones that do not decode themselves (e.g. time.Time).
+func strictStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	return !reflect.PointerTo(t).Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem())
+}
+
+// jsonTypeName returns how a go type is described in the errors of a field.
+func jsonTypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Slice:
+		return "an array of " + strings.TrimPrefix(strings.TrimPrefix(jsonTypeName(t.Elem()), "a "), "an ") + "s"
+	default:
+		return "an object"
+	}
+}
+
+// bindStrict decodes the json object of the body into v, writing a bad request response if it is invalid.
+func bindStrict(ctx *gin.Context, v any) (ok bool) {
+	b, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body", "code": "invalid_request_body"})
+		return
+	}
+
+	fe, err := decodeStrict(b, v, "")
+	return writeFieldErrors(ctx, fe, err)
+}
+
+// bindStrictSlice decodes the json array of objects of the body into v, a pointer to a slice of structs,
+// writing a bad request response if it is invalid. Field errors are keyed as [index].field.
+func bindStrictSlice(ctx *gin.Context, v any) (ok bool) {
+	b, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body", "code": "invalid_request_body"})
+		return
+	}
+
+	var items []json.RawMessage
+	if err = json.Unmarshal(bytes.TrimSpace(b), &items); err != nil || items == nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body", "code": "invalid_request_body"})
+		return
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.MakeSlice(rv.Type(), len(items), len(items)))
+	fe := make(FieldErrors)
+	for i, item := range items {
+		itemErrors, e := decodeStrict(item, rv.Index(i).Addr().Interface(), fmt.Sprintf("[%d].", i))
+		if e != nil {
+			fe[fmt.Sprintf("[%d]", i)] = "must be an object"
+			continue
+		}
+		for k, msg := range itemErrors {
+			fe[k] = msg
+		}
+	}
+	return writeFieldErrors(ctx, fe, nil)
+}
+
+// writeFieldErrors writes a bad request response if the body could not be decoded or has field errors.
+func writeFieldErrors(ctx *gin.Context, fe FieldErrors, err error) (ok bool) {
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body", "code": "invalid_request_body"})
+		return
+	}
+	if len(fe) > 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body", "code": "invalid_request_body", "fields": fe})
+		return
+	}
+	return true
+}
+
+// invalidVehicleMessage returns the message and the code of the invalid attribute errors of the vehicle service.
+func invalidVehicleMessage(err error) (msg string, code string, ok bool) {
+	for _, e := range []struct {
+		err  error
+		msg  string
+		code string
+	}{
+		{internal.ErrServiceInvalidVehicleBrand, "invalid vehicle brand", "invalid_vehicle_brand"},
+		{internal.ErrServiceInvalidVehicleModel, "invalid vehicle model", "invalid_vehicle_model"},
+		{internal.ErrServiceInvalidVehicleRegistration, "invalid vehicle registration", "invalid_vehicle_registration"},
+		{internal.ErrServiceInvalidVehicleYear, "invalid vehicle year", "invalid_vehicle_year"},
+		{internal.ErrServiceInvalidVehicleColor, "invalid vehicle color", "invalid_vehicle_color"},
+		{internal.ErrServiceInvalidVehicleMaxSpeed, "invalid vehicle max speed", "invalid_vehicle_max_speed"},
+		{internal.ErrServiceInvalidVehicleFuelType, "invalid vehicle fuel type", "invalid_vehicle_fuel_type"},
+		{internal.ErrServiceInvalidVehicleTransmission, "invalid vehicle transmission", "invalid_vehicle_transmission"},
+		{internal.ErrServiceInvalidVehiclePassengers, "invalid vehicle passengers", "invalid_vehicle_passengers"},
+		{internal.ErrServiceInvalidVehicleHeight, "invalid vehicle height", "invalid_vehicle_height"},
+		{internal.ErrServiceInvalidVehicleWidth, "invalid vehicle width", "invalid_vehicle_width"},
+		{internal.ErrServiceInvalidVehicleWeight, "invalid vehicle weight", "invalid_vehicle_weight"},
+		{internal.ErrServiceInvalidVehicleBatteryCapacity, "invalid vehicle battery capacity", "invalid_vehicle_battery_capacity"},
+		{internal.ErrServiceInvalidVehicleRange, "invalid vehicle range", "invalid_vehicle_range"},
+		{internal.ErrServiceInvalidVehicleChargingConnector, "invalid vehicle charging connector", "invalid_vehicle_charging_connector"},
+		{internal.ErrServiceInvalidVehicleOwner, "invalid vehicle owner", "invalid_vehicle_owner"},
+	} {
+		if errors.Is(err, e.err) {
+			return e.msg, e.code, true
+		}
+	}
+	return
+}
+
+// invalidQueryMessage returns the message and the code of the invalid query errors
+// of the aggregations, searches and similar vehicles.
+func invalidQueryMessage(err error) (msg string, code string, ok bool) {
+	for _, e := range []struct {
+		err  error
+		msg  string
+		code string
+	}{
+		{internal.ErrServiceInvalidStatsGroupBy, "invalid group by", "invalid_stats_group_by"},
+		{internal.ErrServiceInvalidStatsMetric, "invalid metric", "invalid_stats_metric"},
+		{internal.ErrServiceInvalidHistogramAttribute, "invalid field", "invalid_histogram_attribute"},
+		{internal.ErrServiceInvalidHistogramBuckets, "invalid buckets", "invalid_histogram_buckets"},
+		{internal.ErrServiceInvalidHistogramFilter, "invalid filter", "invalid_histogram_filter"},
+		{internal.ErrServiceInvalidHistogramGroupBy, "invalid group by", "invalid_histogram_group_by"},
+		{internal.ErrServiceInvalidSearchQuery, "invalid query", "invalid_search_query"},
+		{internal.ErrServiceInvalidSearchLimit, "invalid limit", "invalid_search_limit"},
+		{internal.ErrServiceInvalidSimilarWeights, "invalid weights", "invalid_similar_weights"},
+		{internal.ErrServiceInvalidSimilarLimit, "invalid similar limit", "invalid_similar_limit"},
+	} {
+		if errors.Is(err, e.err) {
+			return e.msg, e.code, true
+		}
+	}
+	return
+}
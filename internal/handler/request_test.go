@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBindStrict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hd := NewVehicleDefault(newTestService(), nil, nil)
+	rt := gin.New()
+	rt.POST("/vehicles", hd.Create())
+	rt.POST("/vehicles/batch", hd.CreateMany())
+	rt.PUT("/vehicles/:id/update_speed", hd.UpdateMaxSpeedById())
+	rt.PUT("/vehicles/:id/update_fuel", hd.UpdateFuelTypeById())
+	rt.PUT("/vehicles/:id/owner", hd.UpdateOwnerById())
+
+	// a vehicle body with every required field but the registration
+	partial := `"brand": "Tesla", "model": "Model 3", "color": "Black", "max_speed": 225, "fuel_type": "gasoline",
+		"transmission": "automatic", "passengers": 5, "height": 144, "width": 185, "weight": 1800`
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		// fields are the field errors of the response, nil if the body is not an object at all
+		fields FieldErrors
+	}{
+		{"create with a wrong type", http.MethodPost, "/vehicles", `{` + partial + `, "registration": "DDD444", "year": "2001"}`,
+			FieldErrors{"year": "must be an integer"}},
+		{"create with an unknown field", http.MethodPost, "/vehicles", `{` + partial + `, "registration": "DDD444", "year": 2001, "doors": 4}`,
+			FieldErrors{"doors": "is not a known field"}},
+		{"create without a required field", http.MethodPost, "/vehicles", `{` + partial + `, "year": 2001}`,
+			FieldErrors{"registration": "is required"}},
+		{"create with a null required field", http.MethodPost, "/vehicles", `{` + partial + `, "registration": null, "year": 2001}`,
+			FieldErrors{"registration": "is required"}},
+		{"create with every error", http.MethodPost, "/vehicles", `{` + partial + `, "year": "2001", "doors": 4}`,
+			FieldErrors{"year": "must be an integer", "doors": "is not a known field", "registration": "is required"}},
+		{"create with an array", http.MethodPost, "/vehicles", `[{` + partial + `}]`, nil},
+		{"create with a string", http.MethodPost, "/vehicles", `"vehicle"`, nil},
+		{"create with null", http.MethodPost, "/vehicles", `null`, nil},
+		{"create with invalid json", http.MethodPost, "/vehicles", `{"brand": `, nil},
+
+		{"create many with item errors", http.MethodPost, "/vehicles/batch",
+			`[{` + partial + `, "registration": "DDD444", "year": 2001}, {` + partial + `, "year": "2001"}, 7]`,
+			FieldErrors{"[1].year": "must be an integer", "[1].registration": "is required", "[2]": "must be an object"}},
+		{"create many with an object", http.MethodPost, "/vehicles/batch", `{` + partial + `}`, nil},
+		{"create many with null", http.MethodPost, "/vehicles/batch", `null`, nil},
+
+		{"update speed with a wrong type", http.MethodPut, "/vehicles/1/update_speed", `{"max_speed": "fast"}`,
+			FieldErrors{"max_speed": "must be an integer"}},
+		{"update speed without it", http.MethodPut, "/vehicles/1/update_speed", `{}`,
+			FieldErrors{"max_speed": "is required"}},
+		{"update speed with an unknown field", http.MethodPut, "/vehicles/1/update_speed", `{"max_speed": 200, "speed": 200}`,
+			FieldErrors{"speed": "is not a known field"}},
+		{"update speed with an array", http.MethodPut, "/vehicles/1/update_speed", `[200]`, nil},
+		{"update fuel with wrong types", http.MethodPut, "/vehicles/1/update_fuel", `{"fuel_type": "electric", "battery_capacity": "60", "range": 4.5}`,
+			FieldErrors{"battery_capacity": "must be a number", "range": "must be an integer"}},
+		{"update fuel without it", http.MethodPut, "/vehicles/1/update_fuel", `{"range": 400}`,
+			FieldErrors{"fuel_type": "is required"}},
+		{"update owner with a wrong type", http.MethodPut, "/vehicles/1/owner", `{"owner_id": "1"}`,
+			FieldErrors{"owner_id": "must be an integer"}},
+		{"update owner with an unknown field", http.MethodPut, "/vehicles/1/owner", `{"owner": 1}`,
+			FieldErrors{"owner": "is not a known field"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			res := httptest.NewRecorder()
+			rt.ServeHTTP(res, req)
+			if res.Code != http.StatusBadRequest {
+				t.Fatalf("response = %d %s, want %d", res.Code, res.Body.String(), http.StatusBadRequest)
+			}
+
+			var r struct {
+				Error  string      `json:"error"`
+				Fields FieldErrors `json:"fields"`
+			}
+			if err := json.Unmarshal(res.Body.Bytes(), &r); err != nil {
+				t.Fatal(err)
+			}
+			if r.Error != "invalid request body" {
+				t.Errorf("error = %q", r.Error)
+			}
+			if !maps.Equal(r.Fields, tt.fields) {
+				t.Errorf("fields = %v, want %v", r.Fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestDecodeStrict_Nested(t *testing.T) {
+	type item struct {
+		Name  *string `json:"name" request:"required"`
+		Count int     `json:"count"`
+	}
+	type body struct {
+		Item  *item          `json:"item"`
+		Items []item         `json:"items"`
+		Date  time.Time      `json:"date"`
+		Extra map[string]any `json:"extra"`
+	}
+	tests := []struct {
+		name   string
+		body   string
+		fields FieldErrors
+	}{
+		{"valid", `{"item": {"name": "a", "count": 1}, "items": [{"name": "b"}], "date": "2024-01-01T00:00:00Z", "extra": {"any": 1}}`,
+			FieldErrors{}},
+		{"unknown nested field", `{"item": {"name": "a", "size": 1}}`,
+			FieldErrors{"item.size": "is not a known field"}},
+		{"nested errors", `{"item": {"count": "1"}}`,
+			FieldErrors{"item.name": "is required", "item.count": "must be an integer"}},
+		{"nested item errors", `{"items": [{"name": "a"}, {"name": "b", "size": 1}, 3]}`,
+			FieldErrors{"items[1].size": "is not a known field", "items[2]": "must be an object"}},
+		{"not an object", `{"item": [1], "items": {"name": "a"}}`,
+			FieldErrors{"item": "must be an object", "items": "must be an array of objects"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b body
+			fe, err := decodeStrict([]byte(tt.body), &b, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(fe, tt.fields) {
+				t.Errorf("fields = %v, want %v", fe, tt.fields)
+			}
+		})
+	}
+}
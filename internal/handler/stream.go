@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// StreamEncoder is the interface that wraps the basic methods to write a response item by item.
+type StreamEncoder interface {
+	// ContentType returns the value of the Content-Type header of the responses.
+	ContentType() string
+	// MediaTypes returns the media types of the Accept header the encoder is chosen for.
+	MediaTypes() []string
+	// NewStream returns a stream that writes the items to w.
+	NewStream(w io.Writer) Stream
+}
+
+// Stream is the interface that wraps the basic methods to write items one at a time.
+type Stream interface {
+	// Write writes an item.
+	Write(item any) (err error)
+	// Close writes whatever the format needs after the last item.
+	Close() (err error)
+}
+
+// NewStreamEncoders returns a new set of stream encoders.
+func NewStreamEncoders(encoders ...StreamEncoder) *StreamEncoders {
+	return &StreamEncoders{encoders: encoders}
+}
+
+// DefaultStreamEncoders returns the stream encoders supported by default: ndjson, server-sent events and csv.
+func DefaultStreamEncoders() *StreamEncoders {
+	return NewStreamEncoders(StreamEncoderNDJSON{}, StreamEncoderSSE{}, StreamEncoderCSV{})
+}
+
+// StreamEncoders is an struct that contains the encoders a response can be streamed with.
+type StreamEncoders struct {
+	// encoders is the list of encoders, the first one is the default.
+	encoders []StreamEncoder
+}
+
+// Register adds a stream encoder, replacing the one with the same content type.
+func (es *StreamEncoders) Register(e StreamEncoder) {
+	for i, registered := range es.encoders {
+		if registered.ContentType() == e.ContentType() {
+			es.encoders[i] = e
+			return
+		}
+	}
+	es.encoders = append(es.encoders, e)
+}
+
+// Negotiate returns the stream encoder explicitly requested by the Accept header.
+// Wildcards are not matched, so clients only get a stream when they ask for one.
+func (es *StreamEncoders) Negotiate(accept string) (e StreamEncoder, ok bool) {
+	for _, mediaType := range parseAccept(accept) {
+		for _, encoder := range es.encoders {
+			for _, mt := range encoder.MediaTypes() {
+				if mt == mediaType {
+					return encoder, true
+				}
+			}
+		}
+	}
+	return
+}
+
+// StreamEncoderNDJSON is an struct that streams newline delimited json, an item per line.
+type StreamEncoderNDJSON struct{}
+
+// ContentType returns the content type of ndjson.
+func (StreamEncoderNDJSON) ContentType() string {
+	return "application/x-ndjson"
+}
+
+// MediaTypes returns the media types of ndjson.
+func (StreamEncoderNDJSON) MediaTypes() []string {
+	return []string{"application/x-ndjson", "application/ndjson"}
+}
+
+// NewStream returns a stream of ndjson.
+func (StreamEncoderNDJSON) NewStream(w io.Writer) Stream {
+	return &streamNDJSON{enc: json.NewEncoder(w)}
+}
+
+// streamNDJSON is an struct that writes items as ndjson.
+type streamNDJSON struct {
+	enc *json.Encoder
+}
+
+// Write writes an item as a line of json.
+func (s *streamNDJSON) Write(item any) (err error) {
+	return s.enc.Encode(item)
+}
+
+// Close does nothing, ndjson has no trailer.
+func (s *streamNDJSON) Close() (err error) {
+	return
+}
+
+// StreamEncoderSSE is an struct that streams server-sent events, an event per item.
+type StreamEncoderSSE struct{}
+
+// ContentType returns the content type of server-sent events.
+func (StreamEncoderSSE) ContentType() string {
+	return "text/event-stream"
+}
+
+// MediaTypes returns the media types of server-sent events.
+func (StreamEncoderSSE) MediaTypes() []string {
+	return []string{"text/event-stream"}
+}
+
+// NewStream returns a stream of server-sent events.
+func (StreamEncoderSSE) NewStream(w io.Writer) Stream {
+	return &streamSSE{w: w}
+}
+
+// streamSSE is an struct that writes items as server-sent events.
+type streamSSE struct {
+	w io.Writer
+}
+
+// Write writes an item as a data event in json.
+func (s *streamSSE) Write(item any) (err error) {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return
+	}
+	_, err = fmt.Fprintf(s.w, "event: data\ndata: %s\n\n", b)
+	return
+}
+
+// Close writes an end event, so clients know the stream was not cut.
+func (s *streamSSE) Close() (err error) {
+	_, err = io.WriteString(s.w, "event: end\ndata: {}\n\n")
+	return
+}
+
+// StreamEncoderCSV is an struct that streams csv, a header with the json names of the fields and a row per item.
+type StreamEncoderCSV struct{}
+
+// ContentType returns the content type of csv.
+func (StreamEncoderCSV) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+// MediaTypes returns the media types of csv.
+func (StreamEncoderCSV) MediaTypes() []string {
+	return []string{"text/csv"}
+}
+
+// NewStream returns a stream of csv.
+func (StreamEncoderCSV) NewStream(w io.Writer) Stream {
+	return &streamCSV{w: csv.NewWriter(w)}
+}
+
+// streamCSV is an struct that writes items as csv rows.
+type streamCSV struct {
+	w *csv.Writer
+	// fields are the indexes of the fields written, set with the header on the first item.
+	fields [][]int
+}
+
+// Write writes an item as a row, preceded by the header if it is the first one.
+func (s *streamCSV) Write(item any) (err error) {
+	rv := reflect.Indirect(reflect.ValueOf(item))
+	if s.fields == nil {
+		var header []string
+		s.fields, header = csvFields(rv.Type())
+		if err = s.w.Write(header); err != nil {
+			return
+		}
+	}
+
+	record := make([]string, len(s.fields))
+	for i, f := range s.fields {
+		record[i] = csvValue(rv.FieldByIndex(f))
+	}
+	if err = s.w.Write(record); err != nil {
+		return
+	}
+	s.w.Flush()
+	err = s.w.Error()
+	return
+}
+
+// Close flushes the rows written.
+func (s *streamCSV) Close() (err error) {
+	s.w.Flush()
+	err = s.w.Error()
+	return
+}
@@ -6,51 +6,80 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
-// VehicleJSON is an struct that represents a vehicle in json format.
-type VehicleJSON struct {
-	ID           int     `json:"id"`
-	Brand        string  `json:"brand"`
-	Model        string  `json:"model"`
-	Registration string  `json:"registration"`
-	Year         int     `json:"year"`
-	Color        string  `json:"color"`
-	MaxSpeed     int     `json:"max_speed"`
-	FuelType     string  `json:"fuel_type"`
-	Transmission string  `json:"transmission"`
-	Passengers   int     `json:"passengers"`
-	Height       float64 `json:"height"`
-	Width        float64 `json:"width"`
-	Weight       float64 `json:"weight"`
-}
-
 type BodyRequestUpdateMaxSpeed struct {
-	MaxSpeed int `json:"max_speed"`
+	MaxSpeed *int `json:"max_speed" request:"required"`
 }
 
+// BodyRequestUpdateFuelType is an struct that represents the body to set the fuel type of a vehicle.
+// The energy attributes replace the ones of the vehicle, a missing one is removed.
 type BodyRequestUpdateFuelType struct {
-	FuelType string `json:"fuel_type"`
+	FuelType *string `json:"fuel_type" request:"required"`
+	// BatteryCapacity, Range and ChargingConnector are required by the fuel type, not by the body.
+	BatteryCapacity   *float64 `json:"battery_capacity"`
+	Range             *int     `json:"range"`
+	ChargingConnector *string  `json:"charging_connector"`
+}
+
+// fuel returns the fuel of the body, it must have been decoded with every required field.
+func (b BodyRequestUpdateFuelType) fuel() internal.VehicleFuel {
+	f := internal.VehicleFuel{FuelType: *b.FuelType}
+	if b.BatteryCapacity != nil {
+		f.BatteryCapacity = *b.BatteryCapacity
+	}
+	if b.Range != nil {
+		f.Range = *b.Range
+	}
+	if b.ChargingConnector != nil {
+		f.ChargingConnector = *b.ChargingConnector
+	}
+	return f
+}
+
+// BodyRequestUpdateOwner is an struct that represents the body to set the owner of a vehicle.
+// A missing, null or 0 owner removes the owner of the vehicle.
+type BodyRequestUpdateOwner struct {
+	OwnerID *int `json:"owner_id"`
 }
 
 // NewVehicleDefault returns a new instance of a vehicle handler.
-func NewVehicleDefault(sv internal.ServiceVehicle) *VehicleDefault {
-	return &VehicleDefault{sv: sv}
+// The responses are written with the encoder negotiated with the Accept header, the default encoders if enc or st are nil.
+func NewVehicleDefault(sv internal.ServiceVehicle, enc *Encoders, st *StreamEncoders) *VehicleDefault {
+	if enc == nil {
+		enc = DefaultEncoders()
+	}
+	if st == nil {
+		st = DefaultStreamEncoders()
+	}
+	return &VehicleDefault{sv: sv, enc: enc, st: st}
 }
 
 // VehicleDefault is an struct that contains handlers for vehicle.
 type VehicleDefault struct {
 	sv internal.ServiceVehicle
+	// enc are the encoders the responses can be written with.
+	enc *Encoders
+	// st are the encoders the lists of vehicles can be streamed with.
+	st *StreamEncoders
 }
 
 // GetAll returns all vehicles.
 func (hd *VehicleDefault) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// request
-		// ...
+		// - units of the dimensions and weights of the response
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+		// - stream the vehicles if the client asked for a streaming format
+		if st, ok := hd.st.Negotiate(ctx.GetHeader("Accept")); ok {
+			hd.stream(ctx, st, us)
+			return
+		}
 
 		// process
 		// - get all vehicles from the service
@@ -58,110 +87,69 @@ func (hd *VehicleDefault) GetAll() gin.HandlerFunc {
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"message": "vehicles not found"})
+				ctx.JSON(http.StatusNotFound, gin.H{"message": "vehicles not found", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error", "code": "internal_error"})
 			}
 			return
 		}
 
 		// response
 		// - serialize vehicles
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{"message": "success to find vehicles", "data": data})
+		respond(ctx, hd.enc, http.StatusOK, Envelope{Message: "success to find vehicles", Data: data})
 	}
 }
 
 func (hd *VehicleDefault) Create() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		var m map[string]any
-		if err := ctx.ShouldBindJSON(&m); err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		us, ok := unitSystem(ctx)
+		if !ok {
 			return
 		}
 
-		requiredFields := []string{"brand", "model", "registration", "year", "color", "max_speed", "fuel_type", "transmission", "passengers", "height", "width", "weight"}
-		for _, field := range requiredFields {
-			if _, exists := m[field]; !exists {
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("missing required field: %s", field)})
-				return
-			}
-		}
-
-		vehicle := internal.Vehicle{
-			Attributes: internal.VehicleAttributes{
-				Brand:        m["brand"].(string),
-				Model:        m["model"].(string),
-				Registration: m["registration"].(string),
-				Year:         int(m["year"].(float64)),
-				Color:        m["color"].(string),
-				MaxSpeed:     int(m["max_speed"].(float64)),
-				FuelType:     m["fuel_type"].(string),
-				Transmission: m["transmission"].(string),
-				Passengers:   int(m["passengers"].(float64)),
-				Height:       m["height"].(float64),
-				Width:        m["width"].(float64),
-				Weight:       m["weight"].(float64),
-			},
+		var body BodyRequestVehicle
+		if !bindStrict(ctx, &body) {
+			return
 		}
+		vehicle := body.vehicle(us)
 
 		newVehicle, err := hd.sv.Insert(vehicle)
 		if err != nil {
 			switch {
-			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand) || errors.Is(err, internal.ErrServiceInvalidVehicleModel) || errors.Is(err, internal.ErrServiceInvalidVehicleRegistration) || errors.Is(err, internal.ErrServiceInvalidVehicleYear) || errors.Is(err, internal.ErrServiceInvalidVehicleColor) || errors.Is(err, internal.ErrServiceInvalidVehicleMaxSpeed) || errors.Is(err, internal.ErrServiceInvalidVehicleFuelType) || errors.Is(err, internal.ErrServiceInvalidVehicleTransmission) || errors.Is(err, internal.ErrServiceInvalidVehiclePassengers) || errors.Is(err, internal.ErrServiceInvalidVehicleHeight) || errors.Is(err, internal.ErrServiceInvalidVehicleWidth) || errors.Is(err, internal.ErrServiceInvalidVehicleWeight):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle"})
+			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand) || errors.Is(err, internal.ErrServiceInvalidVehicleModel) || errors.Is(err, internal.ErrServiceInvalidVehicleRegistration) || errors.Is(err, internal.ErrServiceInvalidVehicleYear) || errors.Is(err, internal.ErrServiceInvalidVehicleColor) || errors.Is(err, internal.ErrServiceInvalidVehicleMaxSpeed) || errors.Is(err, internal.ErrServiceInvalidVehicleFuelType) || errors.Is(err, internal.ErrServiceInvalidVehicleTransmission) || errors.Is(err, internal.ErrServiceInvalidVehiclePassengers) || errors.Is(err, internal.ErrServiceInvalidVehicleHeight) || errors.Is(err, internal.ErrServiceInvalidVehicleWidth) || errors.Is(err, internal.ErrServiceInvalidVehicleWeight) || errors.Is(err, internal.ErrServiceInvalidVehicleBatteryCapacity) || errors.Is(err, internal.ErrServiceInvalidVehicleRange) || errors.Is(err, internal.ErrServiceInvalidVehicleChargingConnector) || errors.Is(err, internal.ErrServiceInvalidVehicleOwner):
+				// - the message tells which attribute is invalid
+				msg, code, _ := invalidVehicleMessage(err)
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": msg, "code": code})
 			case errors.Is(err, internal.ErrServiceVehicleIdAlreadyExists):
-				ctx.JSON(http.StatusConflict, gin.H{"error": "vehicle already exists"})
+				ctx.JSON(http.StatusConflict, gin.H{"error": "vehicle already exists", "code": "vehicle_already_exists"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		ctx.JSON(http.StatusCreated, gin.H{
-			"message": "vehicle created",
-			"data": VehicleJSON{
-				ID:           newVehicle.ID,
-				Brand:        newVehicle.Attributes.Brand,
-				Model:        newVehicle.Attributes.Model,
-				Registration: newVehicle.Attributes.Registration,
-				Year:         newVehicle.Attributes.Year,
-				Color:        newVehicle.Attributes.Color,
-				MaxSpeed:     newVehicle.Attributes.MaxSpeed,
-				FuelType:     newVehicle.Attributes.FuelType,
-				Transmission: newVehicle.Attributes.Transmission,
-				Passengers:   newVehicle.Attributes.Passengers,
-				Height:       newVehicle.Attributes.Height,
-				Width:        newVehicle.Attributes.Width,
-				Weight:       newVehicle.Attributes.Weight,
-			},
+		respond(ctx, hd.enc, http.StatusCreated, Envelope{
+			Message: "vehicle created",
+			Data:    internal.SerializeVehicle(newVehicle, us),
 		})
 	}
 }
 
 func (hd *VehicleDefault) GetAllByColorAndYear() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
 		color := ctx.Param("color")
 		year, err := strconv.Atoi(ctx.Param("year"))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid year"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid year", "code": "invalid_vehicle_year"})
 			return
 		}
 
@@ -169,88 +157,75 @@ func (hd *VehicleDefault) GetAllByColorAndYear() gin.HandlerFunc {
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleColor) || errors.Is(err, internal.ErrServiceInvalidVehicleYear):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid params"})
+				// - the message tells which attribute is invalid
+				msg, code, _ := invalidVehicleMessage(err)
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": msg, "code": code})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that color and year"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that color and year", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": "vehicles with that color and year were found",
-			"data":    data,
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that color and year were found",
+			Data:    data,
 		})
 	}
 }
 
 func (hd *VehicleDefault) GetAllByBrandAndBetweenYears() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
 		brand := ctx.Param("brand")
 		startYear, err := strconv.Atoi(ctx.Param("start_year"))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid year"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid year", "code": "invalid_vehicle_year"})
 			return
 		}
 		endYear, err := strconv.Atoi(ctx.Param("end_year"))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid year"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid year", "code": "invalid_vehicle_year"})
+			return
+		}
+		// - both years are included, the years query param narrowing them as the other range filters (e.g. <2020 excludes 2020)
+		years, ok := queryInterval(ctx, "years", false, parseInteger)
+		if !ok {
 			return
 		}
+		years = internal.ClosedInterval(float64(startYear), float64(endYear)).Intersect(years)
 
-		vehicles, err := hd.sv.FindAllByBrandAndBetweenYears(brand, startYear, endYear)
+		vehicles, err := hd.sv.FindAllByBrandAndBetweenYears(brand, years)
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand) || errors.Is(err, internal.ErrServiceInvalidVehicleYear):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid params"})
+				// - the message tells which attribute is invalid
+				msg, code, _ := invalidVehicleMessage(err)
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": msg, "code": code})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that brand and range of years"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that brand and range of years", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": "vehicles with that brand and range of years were found",
-			"data":    data,
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that brand and range of years were found",
+			Data:    data,
 		})
 	}
 }
@@ -263,183 +238,131 @@ func (hd *VehicleDefault) CalculateAverageSpeedByBrand() gin.HandlerFunc {
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid brand"})
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid brand", "code": "invalid_vehicle_brand"})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that brand"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that brand", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": fmt.Sprintf("the average max speed of %s vehicles is %.2f", brand, avg),
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: fmt.Sprintf("the average max speed of %s vehicles is %.2f", brand, avg),
 		})
 	}
 }
 
 func (hd *VehicleDefault) CreateMany() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		var ms []map[string]any
-		if err := ctx.ShouldBindJSON(&ms); err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		us, ok := unitSystem(ctx)
+		if !ok {
 			return
 		}
 
-		vhToInsert := make([]internal.Vehicle, 0)
-		requiredFields := []string{"brand", "model", "registration", "year", "color", "max_speed", "fuel_type", "transmission", "passengers", "height", "width", "weight"}
-		for i := range ms {
-			for _, field := range requiredFields {
-				if _, exists := ms[i][field]; !exists {
-					ctx.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("missing required field: %s", field)})
-					return
-				}
-			}
-
-			vehicle := internal.Vehicle{
-				Attributes: internal.VehicleAttributes{
-					Brand:        ms[i]["brand"].(string),
-					Model:        ms[i]["model"].(string),
-					Registration: ms[i]["registration"].(string),
-					Year:         int(ms[i]["year"].(float64)),
-					Color:        ms[i]["color"].(string),
-					MaxSpeed:     int(ms[i]["max_speed"].(float64)),
-					FuelType:     ms[i]["fuel_type"].(string),
-					Transmission: ms[i]["transmission"].(string),
-					Passengers:   int(ms[i]["passengers"].(float64)),
-					Height:       ms[i]["height"].(float64),
-					Width:        ms[i]["width"].(float64),
-					Weight:       ms[i]["weight"].(float64),
-				},
-			}
-			vhToInsert = append(vhToInsert, vehicle)
+		var body []BodyRequestVehicle
+		if !bindStrictSlice(ctx, &body) {
+			return
+		}
+		vhToInsert := make([]internal.Vehicle, len(body))
+		for i := range body {
+			vhToInsert[i] = body[i].vehicle(us)
 		}
 
 		newVehicles, err := hd.sv.InsertMany(vhToInsert)
 		if err != nil {
 			switch {
-			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand) || errors.Is(err, internal.ErrServiceInvalidVehicleModel) || errors.Is(err, internal.ErrServiceInvalidVehicleRegistration) || errors.Is(err, internal.ErrServiceInvalidVehicleYear) || errors.Is(err, internal.ErrServiceInvalidVehicleColor) || errors.Is(err, internal.ErrServiceInvalidVehicleMaxSpeed) || errors.Is(err, internal.ErrServiceInvalidVehicleFuelType) || errors.Is(err, internal.ErrServiceInvalidVehicleTransmission) || errors.Is(err, internal.ErrServiceInvalidVehiclePassengers) || errors.Is(err, internal.ErrServiceInvalidVehicleHeight) || errors.Is(err, internal.ErrServiceInvalidVehicleWidth) || errors.Is(err, internal.ErrServiceInvalidVehicleWeight):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "some vehicles are invalid"})
+			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand) || errors.Is(err, internal.ErrServiceInvalidVehicleModel) || errors.Is(err, internal.ErrServiceInvalidVehicleRegistration) || errors.Is(err, internal.ErrServiceInvalidVehicleYear) || errors.Is(err, internal.ErrServiceInvalidVehicleColor) || errors.Is(err, internal.ErrServiceInvalidVehicleMaxSpeed) || errors.Is(err, internal.ErrServiceInvalidVehicleFuelType) || errors.Is(err, internal.ErrServiceInvalidVehicleTransmission) || errors.Is(err, internal.ErrServiceInvalidVehiclePassengers) || errors.Is(err, internal.ErrServiceInvalidVehicleHeight) || errors.Is(err, internal.ErrServiceInvalidVehicleWidth) || errors.Is(err, internal.ErrServiceInvalidVehicleWeight) || errors.Is(err, internal.ErrServiceInvalidVehicleBatteryCapacity) || errors.Is(err, internal.ErrServiceInvalidVehicleRange) || errors.Is(err, internal.ErrServiceInvalidVehicleChargingConnector) || errors.Is(err, internal.ErrServiceInvalidVehicleOwner):
+				// - the message tells which attribute is invalid
+				msg, code, _ := invalidVehicleMessage(err)
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": msg, "code": code})
 			case errors.Is(err, internal.ErrServiceVehicleIdAlreadyExists):
-				ctx.JSON(http.StatusConflict, gin.H{"error": "some vehicles already exists"})
+				ctx.JSON(http.StatusConflict, gin.H{"error": "some vehicles already exists", "code": "vehicle_already_exists"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		newVehiclesJSON := make([]VehicleJSON, 0)
-		for _, v := range newVehicles {
-			newVehiclesJSON = append(newVehiclesJSON, VehicleJSON{
-				ID:           v.ID,
-				Brand:        v.Attributes.Brand,
-				Model:        v.Attributes.Model,
-				Registration: v.Attributes.Registration,
-				Year:         v.Attributes.Year,
-				Color:        v.Attributes.Color,
-				MaxSpeed:     v.Attributes.MaxSpeed,
-				FuelType:     v.Attributes.FuelType,
-				Transmission: v.Attributes.Transmission,
-				Passengers:   v.Attributes.Passengers,
-				Height:       v.Attributes.Height,
-				Width:        v.Attributes.Width,
-				Weight:       v.Attributes.Weight,
-			})
-		}
-		ctx.JSON(http.StatusCreated, gin.H{
-			"message": "vehicles created",
-			"data":    newVehiclesJSON,
+		newVehiclesJSON := make([]internal.VehicleJSON, len(newVehicles))
+		for i, v := range newVehicles {
+			newVehiclesJSON[i] = internal.SerializeVehicle(v, us)
+		}
+		respond(ctx, hd.enc, http.StatusCreated, Envelope{
+			Message: "vehicles created",
+			Data:    newVehiclesJSON,
 		})
 	}
 }
 
 func (hd *VehicleDefault) UpdateMaxSpeedById() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
 		id, err := strconv.Atoi(ctx.Param("id"))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
 			return
 		}
 
 		var body BodyRequestUpdateMaxSpeed
-		if err := ctx.ShouldBindJSON(&body); err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		if !bindStrict(ctx, &body) {
 			return
 		}
 
-		uv, err := hd.sv.UpdateMaxSpeedById(id, body.MaxSpeed)
+		uv, err := hd.sv.UpdateMaxSpeedById(id, *body.MaxSpeed)
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleMaxSpeed):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle max speed"})
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle max speed", "code": "invalid_vehicle_max_speed"})
 			case errors.Is(err, internal.ErrServiceVehicleNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found", "code": "vehicle_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		uvJSON := VehicleJSON{
-			ID:           uv.ID,
-			Brand:        uv.Attributes.Brand,
-			Model:        uv.Attributes.Model,
-			Registration: uv.Attributes.Registration,
-			Year:         uv.Attributes.Year,
-			Color:        uv.Attributes.Color,
-			MaxSpeed:     uv.Attributes.MaxSpeed,
-			FuelType:     uv.Attributes.FuelType,
-			Transmission: uv.Attributes.Transmission,
-			Passengers:   uv.Attributes.Passengers,
-			Height:       uv.Attributes.Height,
-			Width:        uv.Attributes.Width,
-			Weight:       uv.Attributes.Weight,
-		}
-		ctx.JSON(http.StatusCreated, gin.H{
-			"message": "updated max speed of vehicle",
-			"data":    uvJSON,
+		uvJSON := internal.SerializeVehicle(uv, us)
+		respond(ctx, hd.enc, http.StatusCreated, Envelope{
+			Message: "updated max speed of vehicle",
+			Data:    uvJSON,
 		})
 	}
 }
 
 func (hd *VehicleDefault) GetAllByFuelType() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
 		ft := ctx.Param("type")
 
 		vehicles, err := hd.sv.FindAllByFuelType(ft)
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleFuelType):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle fuel type"})
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle fuel type", "code": "invalid_vehicle_fuel_type"})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that fuel type"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that fuel type", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": "vehicles with that fuel type were found",
-			"data":    data,
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that fuel type were found",
+			Data:    data,
 		})
 	}
 }
@@ -448,16 +371,16 @@ func (hd *VehicleDefault) Delete() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		id, err := strconv.Atoi(ctx.Param("id"))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
 			return
 		}
 
 		if err := hd.sv.Delete(id); err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceVehicleNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found", "code": "vehicle_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
@@ -468,91 +391,117 @@ func (hd *VehicleDefault) Delete() gin.HandlerFunc {
 
 func (hd *VehicleDefault) GetAllByTransmission() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
 		t := ctx.Param("type")
 
 		vehicles, err := hd.sv.FindAllByTransmission(t)
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleTransmission):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle transmission"})
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle transmission", "code": "invalid_vehicle_transmission"})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that transmission"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that transmission", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": "vehicles with that transmission were found",
-			"data":    data,
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that transmission were found",
+			Data:    data,
 		})
 	}
 }
 
 func (hd *VehicleDefault) UpdateFuelTypeById() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
 		id, err := strconv.Atoi(ctx.Param("id"))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier"})
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
 			return
 		}
 
 		var body BodyRequestUpdateFuelType
-		if err := ctx.ShouldBindJSON(&body); err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		if !bindStrict(ctx, &body) {
 			return
 		}
 
-		uv, err := hd.sv.UpdateFuelTypeById(id, body.FuelType)
+		uv, err := hd.sv.UpdateFuelTypeById(id, body.fuel())
 		if err != nil {
 			switch {
-			case errors.Is(err, internal.ErrServiceInvalidVehicleFuelType):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle fuel type"})
+			case errors.Is(err, internal.ErrServiceInvalidVehicleFuelType) || errors.Is(err, internal.ErrServiceInvalidVehicleBatteryCapacity) || errors.Is(err, internal.ErrServiceInvalidVehicleRange) || errors.Is(err, internal.ErrServiceInvalidVehicleChargingConnector):
+				// - the message tells which attribute is invalid
+				msg, code, _ := invalidVehicleMessage(err)
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": msg, "code": code})
 			case errors.Is(err, internal.ErrServiceVehicleNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found", "code": "vehicle_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		uvJSON := VehicleJSON{
-			ID:           uv.ID,
-			Brand:        uv.Attributes.Brand,
-			Model:        uv.Attributes.Model,
-			Registration: uv.Attributes.Registration,
-			Year:         uv.Attributes.Year,
-			Color:        uv.Attributes.Color,
-			MaxSpeed:     uv.Attributes.MaxSpeed,
-			FuelType:     uv.Attributes.FuelType,
-			Transmission: uv.Attributes.Transmission,
-			Passengers:   uv.Attributes.Passengers,
-			Height:       uv.Attributes.Height,
-			Width:        uv.Attributes.Width,
-			Weight:       uv.Attributes.Weight,
-		}
-		ctx.JSON(http.StatusCreated, gin.H{
-			"message": "updated fuel type of vehicle",
-			"data":    uvJSON,
+		uvJSON := internal.SerializeVehicle(uv, us)
+		respond(ctx, hd.enc, http.StatusCreated, Envelope{
+			Message: "updated fuel type of vehicle",
+			Data:    uvJSON,
+		})
+	}
+}
+
+// UpdateOwnerById sets the owner of a vehicle to the one of the body, which must exist.
+func (hd *VehicleDefault) UpdateOwnerById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		var body BodyRequestUpdateOwner
+		if !bindStrict(ctx, &body) {
+			return
+		}
+		var ownerId int
+		if body.OwnerID != nil {
+			ownerId = *body.OwnerID
+		}
+
+		uv, err := hd.sv.UpdateOwnerById(id, ownerId)
+		if err != nil {
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidVehicleOwner):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle owner", "code": "invalid_vehicle_owner"})
+			case errors.Is(err, internal.ErrServiceVehicleNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found", "code": "vehicle_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
+			return
+		}
+
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "updated owner of vehicle",
+			Data:    internal.SerializeVehicle(uv, us),
 		})
 	}
 }
@@ -565,141 +514,270 @@ func (hd *VehicleDefault) CalculateAverageCapacityByBrand() gin.HandlerFunc {
 		if err != nil {
 			switch {
 			case errors.Is(err, internal.ErrServiceInvalidVehicleBrand):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid brand"})
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid brand", "code": "invalid_vehicle_brand"})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that brand"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that brand", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": fmt.Sprintf("the average capacity of %s vehicles is %.2f", brand, avg),
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: fmt.Sprintf("the average capacity of %s vehicles is %.2f", brand, avg),
 		})
 	}
 }
 
+// GetAllByDimensions returns the vehicles with height and width within the intervals of the query params
+// (e.g. 150-200, [150,200) or >=150), a missing one leaving its dimension unbounded.
+// The bounds can have a unit (e.g. 1.5m-2m or 60in-80in), they are in the system of the units query param if not.
 func (hd *VehicleDefault) GetAllByDimensions() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		height := ctx.Query("height")
-		splitH := strings.Split(height, "-")
-		if len(splitH) != 2 {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid height"})
+		us, ok := unitSystem(ctx)
+		if !ok {
 			return
 		}
-		width := ctx.Query("width")
-		splitW := strings.Split(width, "-")
-		if len(splitW) != 2 {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid width"})
+
+		parseLength := func(s string) (float64, error) {
+			return internal.ParseLength(s, us.Length(), internal.VehicleLengthUnit)
+		}
+		height, ok := queryInterval(ctx, "height", false, parseLength)
+		if !ok {
 			return
 		}
-		minH, err := strconv.ParseFloat(splitH[0], 64)
-		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid min height"})
+		width, ok := queryInterval(ctx, "width", false, parseLength)
+		if !ok {
 			return
 		}
-		maxH, err := strconv.ParseFloat(splitH[1], 64)
+
+		vehicles, err := hd.sv.FindAllByDimensions(height, width)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid max height"})
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidVehicleWidth) || errors.Is(err, internal.ErrServiceInvalidVehicleHeight):
+				// - the message tells which attribute is invalid
+				msg, code, _ := invalidVehicleMessage(err)
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": msg, "code": code})
+			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that dimensions", "code": "vehicles_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
 			return
 		}
-		minW, err := strconv.ParseFloat(splitW[0], 64)
-		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid min width"})
+
+		data := make([]internal.VehicleJSON, len(vehicles))
+		for i, vehicle := range vehicles {
+			data[i] = internal.SerializeVehicle(vehicle, us)
+		}
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that dimensions were found",
+			Data:    data,
+		})
+	}
+}
+
+// GetAllByWeights returns the vehicles with weight within the interval of the weight query param
+// (e.g. 1000-2000, (1000,2000] or >=1000) or between the min and max query params, both included and optional.
+// The bounds can have a unit (e.g. 1.2t or 3000lb), they are in the system of the units query param if not.
+func (hd *VehicleDefault) GetAllByWeights() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
 			return
 		}
-		maxW, err := strconv.ParseFloat(splitW[1], 64)
-		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid max width"})
+
+		weight, ok := queryInterval(ctx, "weight", true, func(s string) (float64, error) {
+			return internal.ParseWeight(s, us.Weight(), internal.VehicleWeightUnit)
+		})
+		if !ok {
 			return
 		}
 
-		vehicles, err := hd.sv.FindAllByDimensions(minH, maxH, minW, maxW)
+		vehicles, err := hd.sv.FindAllByWeight(weight)
 		if err != nil {
 			switch {
-			case errors.Is(err, internal.ErrServiceInvalidVehicleWidth) || errors.Is(err, internal.ErrServiceInvalidVehicleHeight):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid dimensions"})
+			case errors.Is(err, internal.ErrServiceInvalidVehicleWeight):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid weight", "code": "invalid_vehicle_weight"})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that dimensions"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that weight", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": "vehicles with that dimensions were found",
-			"data":    data,
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that weight were found",
+			Data:    data,
 		})
 	}
 }
 
-func (hd *VehicleDefault) GetAllByWeights() gin.HandlerFunc {
+func (hd *VehicleDefault) GetAllByChargingConnector() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		minW, err := strconv.ParseFloat(ctx.Query("min"), 64)
-		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid min weight"})
+		us, ok := unitSystem(ctx)
+		if !ok {
 			return
 		}
-		maxW, err := strconv.ParseFloat(ctx.Query("max"), 64)
+
+		connector := ctx.Param("connector")
+
+		vehicles, err := hd.sv.FindAllByChargingConnector(connector)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid max weight"})
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidVehicleChargingConnector):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle charging connector", "code": "invalid_vehicle_charging_connector"})
+			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that charging connector", "code": "vehicles_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
+			return
+		}
+
+		data := make([]internal.VehicleJSON, len(vehicles))
+		for i, vehicle := range vehicles {
+			data[i] = internal.SerializeVehicle(vehicle, us)
+		}
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that charging connector were found",
+			Data:    data,
+		})
+	}
+}
+
+// GetAllByRange returns the electric and hybrid vehicles with range within the interval of the range query param
+// (e.g. 300-500, [300,500) or >=300) or between the min and max query params, both included and optional.
+func (hd *VehicleDefault) GetAllByRange() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
+		r, ok := queryInterval(ctx, "range", true, parseNumber)
+		if !ok {
 			return
 		}
 
-		vehicles, err := hd.sv.FindAllByWeight(minW, maxW)
+		vehicles, err := hd.sv.FindAllByRange(r)
 		if err != nil {
 			switch {
-			case errors.Is(err, internal.ErrServiceInvalidVehicleWeight):
-				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid weight"})
+			case errors.Is(err, internal.ErrServiceInvalidVehicleRange):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid range", "code": "invalid_vehicle_range"})
 			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
-				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that weight"})
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any vehicles with that range", "code": "vehicles_not_found"})
 			default:
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred"})
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
 			}
 			return
 		}
 
-		data := make([]VehicleJSON, len(vehicles))
+		data := make([]internal.VehicleJSON, len(vehicles))
 		for i, vehicle := range vehicles {
-			data[i] = VehicleJSON{
-				ID:           vehicle.ID,
-				Brand:        vehicle.Attributes.Brand,
-				Model:        vehicle.Attributes.Model,
-				Registration: vehicle.Attributes.Registration,
-				Year:         vehicle.Attributes.Year,
-				Color:        vehicle.Attributes.Color,
-				MaxSpeed:     vehicle.Attributes.MaxSpeed,
-				FuelType:     vehicle.Attributes.FuelType,
-				Transmission: vehicle.Attributes.Transmission,
-				Passengers:   vehicle.Attributes.Passengers,
-				Height:       vehicle.Attributes.Height,
-				Width:        vehicle.Attributes.Width,
-				Weight:       vehicle.Attributes.Weight,
-			}
+			data[i] = internal.SerializeVehicle(vehicle, us)
 		}
-		ctx.JSON(http.StatusOK, gin.H{
-			"message": "vehicles with that weight were found",
-			"data":    data,
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicles with that range were found",
+			Data:    data,
 		})
 	}
 }
+
+// exportFormats are the media types of the formats of the export, by name.
+var exportFormats = map[string]string{
+	"ndjson": "application/x-ndjson",
+	"sse":    "text/event-stream",
+	"csv":    "text/csv",
+}
+
+// Export streams all vehicles as a file, in the format of the format query param (ndjson, sse or csv),
+// the streaming format of the Accept header or ndjson.
+func (hd *VehicleDefault) Export() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		// - units of the dimensions and weights of the response
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+
+		format := ctx.Query("format")
+		mediaType := ctx.GetHeader("Accept")
+		if format != "" {
+			var ok bool
+			mediaType, ok = exportFormats[format]
+			if !ok {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid format", "code": "invalid_format"})
+				return
+			}
+		}
+		st, ok := hd.st.Negotiate(mediaType)
+		if !ok {
+			st, _ = hd.st.Negotiate(exportFormats["ndjson"])
+		}
+
+		// response
+		for name, mt := range exportFormats {
+			if st.MediaTypes()[0] == mt {
+				ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"vehicles.%s\"", name))
+			}
+		}
+		hd.stream(ctx, st, us)
+	}
+}
+
+// streamFlushEvery is the number of vehicles written between flushes of a stream.
+const streamFlushEvery = 64
+
+// stream writes the vehicles as they are read from the service, without building the whole list.
+func (hd *VehicleDefault) stream(ctx *gin.Context, st StreamEncoder, us internal.UnitSystem) {
+	var s Stream
+	n := 0
+	err := hd.sv.ForEach(func(v internal.Vehicle) (err error) {
+		// the response starts with the first vehicle, so errors before it can still be reported
+		if s == nil {
+			ctx.Header("Content-Type", st.ContentType())
+			ctx.Status(http.StatusOK)
+			s = st.NewStream(ctx.Writer)
+		}
+
+		if err = s.Write(internal.SerializeVehicle(v, us)); err != nil {
+			return
+		}
+		n++
+		if n%streamFlushEvery == 0 {
+			ctx.Writer.Flush()
+		}
+		return
+	})
+	// - the repository can be emptied after it is checked, so no vehicle is visited without an error
+	if err == nil && s == nil {
+		err = internal.ErrServiceVehiclesNotFound
+	}
+	if err != nil {
+		if s != nil {
+			// the status was already sent, the stream is just cut
+			_ = ctx.Error(err)
+			return
+		}
+		switch {
+		case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "vehicles not found", "code": "vehicles_not_found"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "internal server error", "code": "internal_error"})
+		}
+		return
+	}
+
+	if err = s.Close(); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.Writer.Flush()
+}
\ No newline at end of file
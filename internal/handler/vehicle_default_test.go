@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"app/internal"
+	vehiclev1 "app/proto/vehicle/v1"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// testVehicleBody is the body of a vehicle to create, its dimensions and weight in imperial units.
+const testVehicleBody = `{"brand": "Tesla", "model": "Model 3", "registration": "DDD444", "year": 2022, "color": "Black",
+	"max_speed": 225, "fuel_type": "electric", "transmission": "automatic", "passengers": 5,
+	"height": 56.69, "width": 72.83, "weight": 3968.32, "battery_capacity": 75, "range": 500, "charging_connector": "CCS2"}`
+
+func TestVehicleDefault_CreateUnits(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		body   string
+	}{
+		{"create", "/vehicles?units=imperial", testVehicleBody},
+		{"create many", "/vehicles/batch?units=imperial", "[" + testVehicleBody + "]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			sv := newTestService()
+			hd := NewVehicleDefault(sv, nil, nil)
+			rt := gin.New()
+			rt.POST("/vehicles", hd.Create())
+			rt.POST("/vehicles/batch", hd.CreateMany())
+
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			res := httptest.NewRecorder()
+			rt.ServeHTTP(res, req)
+			if res.Code != http.StatusCreated {
+				t.Fatalf("response = %d %s", res.Code, res.Body.String())
+			}
+
+			// the response is written in the units of the body
+			var r struct {
+				Data json.RawMessage `json:"data"`
+			}
+			if err := json.Unmarshal(res.Body.Bytes(), &r); err != nil {
+				t.Fatal(err)
+			}
+			var v internal.VehicleJSON
+			if strings.HasPrefix(tt.body, "[") {
+				var vs []internal.VehicleJSON
+				if err := json.Unmarshal(r.Data, &vs); err != nil || len(vs) != 1 {
+					t.Fatalf("data = %s", r.Data)
+				}
+				v = vs[0]
+			} else if err := json.Unmarshal(r.Data, &v); err != nil {
+				t.Fatal(err)
+			}
+			if v.Height != 56.69 || v.Width != 72.83 || v.Weight != 3968.32 || v.LengthUnit != "in" || v.WeightUnit != "lb" {
+				t.Errorf("vehicle = %+v", v)
+			}
+			if v.BatteryCapacity != 75 || v.Range != 500 || v.ChargingConnector != "CCS2" {
+				t.Errorf("vehicle = %+v", v)
+			}
+
+			// and stored in the units of the vehicles
+			vs, err := sv.FindAllByChargingConnector("CCS2")
+			if err != nil || len(vs) != 1 {
+				t.Fatalf("vehicles = %+v, %v", vs, err)
+			}
+			if a := vs[0].Attributes; a.Height != 143.99 || a.Width != 184.99 || a.Weight != 1800 {
+				t.Errorf("stored attributes = %+v", a)
+			}
+		})
+	}
+}
+
+func TestVehicleDefault_GetAllByBrandAndBetweenYears(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hd := NewVehicleDefault(newTestService(), nil, nil)
+	rt := gin.New()
+	rt.GET("/vehicles/brand/:brand/between/:start_year/:end_year", hd.GetAllByBrandAndBetweenYears())
+
+	tests := []struct {
+		name   string
+		target string
+		code   int
+		ids    []int
+	}{
+		{"both years included", "/vehicles/brand/Ford/between/2015/2020", http.StatusOK, []int{1, 2}},
+		{"end year excluded", "/vehicles/brand/Ford/between/2015/2020?years=" + url.QueryEscape("<2020"), http.StatusOK, []int{1}},
+		{"start year excluded", "/vehicles/brand/Ford/between/2015/2020?years=" + url.QueryEscape("(2015,]"), http.StatusOK, []int{2}},
+		{"narrowed to none", "/vehicles/brand/Ford/between/2015/2020?years=" + url.QueryEscape(">2020"), http.StatusBadRequest, nil},
+		{"invalid years", "/vehicles/brand/Ford/between/2015/2020?years=" + url.QueryEscape("[2015,x]"), http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := httptest.NewRecorder()
+			rt.ServeHTTP(res, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if res.Code != tt.code {
+				t.Fatalf("response = %d %s, want %d", res.Code, res.Body.String(), tt.code)
+			}
+			if tt.ids == nil {
+				return
+			}
+
+			var r struct {
+				Data []internal.VehicleJSON `json:"data"`
+			}
+			if err := json.Unmarshal(res.Body.Bytes(), &r); err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]int, len(r.Data))
+			for i, v := range r.Data {
+				ids[i] = v.ID
+			}
+			if !slices.Equal(ids, tt.ids) {
+				t.Errorf("ids = %v, want %v", ids, tt.ids)
+			}
+		})
+	}
+}
+
+func TestVehicleDefault_Source(t *testing.T) {
+	// the vehicle 1 is loaded from a file and the vehicle 2 is not
+	tests := []struct {
+		id     int
+		source string
+	}{
+		{1, "vehicles.json"},
+		{2, ""},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		hd := NewVehicleDefault(newTestService(), nil, nil)
+		rt := gin.New()
+		rt.GET("/vehicles", hd.GetAll())
+
+		res := httptest.NewRecorder()
+		rt.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/vehicles", nil))
+		var r struct {
+			Data []map[string]any `json:"data"`
+		}
+		if err := json.Unmarshal(res.Body.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range tests {
+			source, ok := r.Data[tt.id-1]["source"]
+			if tt.source == "" && ok || tt.source != "" && source != tt.source {
+				t.Errorf("vehicle %d source = %v, want %q", tt.id, source, tt.source)
+			}
+		}
+	})
+
+	t.Run("graphql", func(t *testing.T) {
+		s := newTestGraphQLSchema(t)
+		for _, tt := range tests {
+			var data struct {
+				Vehicle struct {
+					Source *string `json:"source"`
+				} `json:"vehicle"`
+			}
+			graphQLData(t, graphQLDo(t, s, `query($id: Int!) { vehicle(id: $id) { source } }`, map[string]any{"id": tt.id}), &data)
+			if got := data.Vehicle.Source; tt.source == "" && got != nil || tt.source != "" && (got == nil || *got != tt.source) {
+				t.Errorf("vehicle %d source = %v, want %q", tt.id, got, tt.source)
+			}
+		}
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		c := newTestGRPCClient(t)
+		for _, tt := range tests {
+			v, err := c.UpdateOwnerById(context.Background(), &vehiclev1.UpdateOwnerByIdRequest{Id: int64(tt.id)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.GetSource() != tt.source {
+				t.Errorf("vehicle %d source = %q, want %q", tt.id, v.GetSource(), tt.source)
+			}
+		}
+	})
+}
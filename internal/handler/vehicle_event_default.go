@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"app/internal"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VehicleEventJSON is an struct that represents a vehicle event in json format.
+type VehicleEventJSON struct {
+	ID        int64                 `json:"id"`
+	Type      string                `json:"type"`
+	VehicleID int                   `json:"vehicle_id"`
+	Changes   []string              `json:"changes"`
+	Vehicle   *internal.VehicleJSON `json:"vehicle,omitempty"`
+	Time      time.Time             `json:"time"`
+}
+
+// serializeVehicleEvent returns the json representation of a vehicle event, without vehicle if it was deleted.
+func serializeVehicleEvent(e internal.VehicleEvent) VehicleEventJSON {
+	ej := VehicleEventJSON{
+		ID:        e.ID,
+		Type:      string(e.Type),
+		VehicleID: e.VehicleID,
+		Changes:   e.Changes,
+		Time:      e.Time,
+	}
+	if ej.Changes == nil {
+		ej.Changes = []string{}
+	}
+	if e.Type != internal.VehicleEventDeleted {
+		v := internal.SerializeVehicle(e.Vehicle, internal.UnitSystemMetric)
+		ej.Vehicle = &v
+	}
+	return ej
+}
+
+// NewVehicleEventDefault returns a new instance of a vehicle event handler.
+func NewVehicleEventDefault(bus internal.EventBusVehicle) *VehicleEventDefault {
+	return &VehicleEventDefault{bus: bus}
+}
+
+// VehicleEventDefault is an struct that contains handlers for vehicle events.
+type VehicleEventDefault struct {
+	bus internal.EventBusVehicle
+}
+
+// eventsHeartbeat is the interval of the comments sent to keep idle streams open.
+const eventsHeartbeat = 15 * time.Second
+
+// Stream sends the vehicle events as server-sent events.
+// Events can be filtered by type, vehicle_id and changes (comma separated lists) and resumed from
+// the Last-Event-ID header (or last_event_id query param). If the events to resume from were discarded,
+// a reset event is sent first so the client knows it has to fetch the vehicles again.
+func (hd *VehicleEventDefault) Stream() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		// - filter
+		var filter internal.VehicleEventFilter
+		for _, t := range splitQuery(ctx.Query("type")) {
+			et := internal.VehicleEventType(t)
+			if et != internal.VehicleEventCreated && et != internal.VehicleEventUpdated && et != internal.VehicleEventDeleted {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid event type", "code": "invalid_event_type"})
+				return
+			}
+			filter.Types = append(filter.Types, et)
+		}
+		for _, id := range splitQuery(ctx.Query("vehicle_id")) {
+			vid, err := strconv.Atoi(id)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid vehicle identifier", "code": "invalid_vehicle_identifier"})
+				return
+			}
+			filter.VehicleIDs = append(filter.VehicleIDs, vid)
+		}
+		filter.Changes = splitQuery(ctx.Query("changes"))
+		// - resume
+		lastEventId := ctx.GetHeader("Last-Event-ID")
+		if lastEventId == "" {
+			lastEventId = ctx.Query("last_event_id")
+		}
+		var lastId int64
+		if lastEventId != "" {
+			var err error
+			lastId, err = strconv.ParseInt(lastEventId, 10, 64)
+			if err != nil || lastId < 0 {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid last event id", "code": "invalid_last_event_id"})
+				return
+			}
+		}
+
+		// process
+		replay, ch, cancel, err := hd.bus.Subscribe(filter, lastId)
+		expired := errors.Is(err, internal.ErrEventBusVehicleEventsExpired)
+		if err != nil && !expired {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			return
+		}
+		defer cancel()
+
+		// response
+		ctx.Header("Content-Type", "text/event-stream")
+		ctx.Header("Cache-Control", "no-cache")
+		ctx.Header("Connection", "keep-alive")
+		ctx.Status(http.StatusOK)
+		if expired {
+			io.WriteString(ctx.Writer, "event: reset\ndata: {}\n\n")
+		}
+		for _, e := range replay {
+			if err := writeVehicleEvent(ctx.Writer, e); err != nil {
+				return
+			}
+		}
+		ctx.Writer.Flush()
+
+		heartbeat := time.NewTicker(eventsHeartbeat)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case <-ctx.Request.Context().Done():
+				return
+			case <-heartbeat.C:
+				if _, err := io.WriteString(ctx.Writer, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			case e, ok := <-ch:
+				// the subscription is closed if the client fell behind, it can resume with the last event id
+				if !ok {
+					return
+				}
+				if err := writeVehicleEvent(ctx.Writer, e); err != nil {
+					return
+				}
+			}
+			ctx.Writer.Flush()
+		}
+	}
+}
+
+// writeVehicleEvent writes a vehicle event as a server-sent event named after its type.
+func writeVehicleEvent(w io.Writer, e internal.VehicleEvent) (err error) {
+	b, err := json.Marshal(serializeVehicleEvent(e))
+	if err != nil {
+		return
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, b)
+	return
+}
+
+// splitQuery returns the non empty values of a comma separated query param.
+func splitQuery(q string) (values []string) {
+	for _, v := range strings.Split(q, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return
+}
@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"app/internal"
+	vehiclev1 "app/proto/vehicle/v1"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewVehicleGRPC returns a new instance of the grpc vehicle service.
+func NewVehicleGRPC(sv internal.ServiceVehicle) *VehicleGRPC {
+	return &VehicleGRPC{sv: sv}
+}
+
+// VehicleGRPC is an struct that implements the VehicleService of the protobuf definition with the vehicle service.
+type VehicleGRPC struct {
+	vehiclev1.UnimplementedVehicleServiceServer
+	// sv is the service of the vehicles.
+	sv internal.ServiceVehicle
+}
+
+// Register registers the vehicle service in a grpc server.
+func (hd *VehicleGRPC) Register(s *grpc.Server) {
+	vehiclev1.RegisterVehicleServiceServer(s, hd)
+}
+
+func (hd *VehicleGRPC) FindAll(req *vehiclev1.FindAllRequest, stream vehiclev1.VehicleService_FindAllServer) error {
+	err := hd.sv.ForEach(func(v internal.Vehicle) (err error) {
+		return stream.Send(serializeVehicleProto(v))
+	})
+	return grpcError(err)
+}
+
+func (hd *VehicleGRPC) Insert(ctx context.Context, req *vehiclev1.InsertRequest) (*vehiclev1.Vehicle, error) {
+	v := deserializeVehicleProto(req.GetAttributes())
+	v.OwnerID = int(req.GetOwnerId())
+	v, err := hd.sv.Insert(v)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return serializeVehicleProto(v), nil
+}
+
+func (hd *VehicleGRPC) FindAllByColorAndYear(req *vehiclev1.FindAllByColorAndYearRequest, stream vehiclev1.VehicleService_FindAllByColorAndYearServer) error {
+	v, err := hd.sv.FindAllByColorAndYear(req.GetColor(), int(req.GetYear()))
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) FindAllByBrandAndBetweenYears(req *vehiclev1.FindAllByBrandAndBetweenYearsRequest, stream vehiclev1.VehicleService_FindAllByBrandAndBetweenYearsServer) error {
+	v, err := hd.sv.FindAllByBrandAndBetweenYears(req.GetBrand(), intervalProto(req.StartYear, req.EndYear))
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) CalculateAverageSpeedByBrand(ctx context.Context, req *vehiclev1.CalculateAverageByBrandRequest) (*vehiclev1.AverageResponse, error) {
+	avg, err := hd.sv.CalculateAverageSpeedByBrand(req.GetBrand())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &vehiclev1.AverageResponse{Average: avg}, nil
+}
+
+func (hd *VehicleGRPC) InsertMany(ctx context.Context, req *vehiclev1.InsertManyRequest) (*vehiclev1.InsertManyResponse, error) {
+	vs := make([]internal.Vehicle, len(req.GetAttributes()))
+	for i, a := range req.GetAttributes() {
+		vs[i] = deserializeVehicleProto(a)
+	}
+
+	nvs, err := hd.sv.InsertMany(vs)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &vehiclev1.InsertManyResponse{Vehicles: make([]*vehiclev1.Vehicle, len(nvs))}
+	for i, v := range nvs {
+		res.Vehicles[i] = serializeVehicleProto(v)
+	}
+	return res, nil
+}
+
+func (hd *VehicleGRPC) UpdateMaxSpeedById(ctx context.Context, req *vehiclev1.UpdateMaxSpeedByIdRequest) (*vehiclev1.Vehicle, error) {
+	v, err := hd.sv.UpdateMaxSpeedById(int(req.GetId()), int(req.GetMaxSpeed()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return serializeVehicleProto(v), nil
+}
+
+func (hd *VehicleGRPC) FindAllByFuelType(req *vehiclev1.FindAllByFuelTypeRequest, stream vehiclev1.VehicleService_FindAllByFuelTypeServer) error {
+	v, err := hd.sv.FindAllByFuelType(req.GetFuelType())
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) Delete(ctx context.Context, req *vehiclev1.DeleteRequest) (*vehiclev1.DeleteResponse, error) {
+	if err := hd.sv.Delete(int(req.GetId())); err != nil {
+		return nil, grpcError(err)
+	}
+	return &vehiclev1.DeleteResponse{}, nil
+}
+
+func (hd *VehicleGRPC) FindAllByTransmission(req *vehiclev1.FindAllByTransmissionRequest, stream vehiclev1.VehicleService_FindAllByTransmissionServer) error {
+	v, err := hd.sv.FindAllByTransmission(req.GetTransmission())
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) UpdateFuelTypeById(ctx context.Context, req *vehiclev1.UpdateFuelTypeByIdRequest) (*vehiclev1.Vehicle, error) {
+	v, err := hd.sv.UpdateFuelTypeById(int(req.GetId()), internal.VehicleFuel{
+		FuelType:          req.GetFuelType(),
+		BatteryCapacity:   req.GetBatteryCapacity(),
+		Range:             int(req.GetRange()),
+		ChargingConnector: req.GetChargingConnector(),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return serializeVehicleProto(v), nil
+}
+
+func (hd *VehicleGRPC) CalculateAverageCapacityByBrand(ctx context.Context, req *vehiclev1.CalculateAverageByBrandRequest) (*vehiclev1.AverageResponse, error) {
+	avg, err := hd.sv.CalculateAverageCapacityByBrand(req.GetBrand())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &vehiclev1.AverageResponse{Average: avg}, nil
+}
+
+func (hd *VehicleGRPC) FindAllByDimensions(req *vehiclev1.FindAllByDimensionsRequest, stream vehiclev1.VehicleService_FindAllByDimensionsServer) error {
+	height := intervalProto(req.MinHeight, req.MaxHeight)
+	width := intervalProto(req.MinWidth, req.MaxWidth)
+	v, err := hd.sv.FindAllByDimensions(height, width)
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) FindAllByWeight(req *vehiclev1.FindAllByWeightRequest, stream vehiclev1.VehicleService_FindAllByWeightServer) error {
+	v, err := hd.sv.FindAllByWeight(intervalProto(req.MinWeight, req.MaxWeight))
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) FindAllByChargingConnector(req *vehiclev1.FindAllByChargingConnectorRequest, stream vehiclev1.VehicleService_FindAllByChargingConnectorServer) error {
+	v, err := hd.sv.FindAllByChargingConnector(req.GetChargingConnector())
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) FindAllByRange(req *vehiclev1.FindAllByRangeRequest, stream vehiclev1.VehicleService_FindAllByRangeServer) error {
+	v, err := hd.sv.FindAllByRange(intervalProto(req.MinRange, req.MaxRange))
+	return sendVehiclesProto(stream, v, err)
+}
+
+func (hd *VehicleGRPC) UpdateOwnerById(ctx context.Context, req *vehiclev1.UpdateOwnerByIdRequest) (*vehiclev1.Vehicle, error) {
+	v, err := hd.sv.UpdateOwnerById(int(req.GetId()), int(req.GetOwnerId()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return serializeVehicleProto(v), nil
+}
+
+func (hd *VehicleGRPC) CalculateStats(ctx context.Context, req *vehiclev1.CalculateStatsRequest) (*vehiclev1.CalculateStatsResponse, error) {
+	q := internal.VehicleStatsQuery{GroupBy: req.GetGroupBy(), Metrics: make([]internal.VehicleStatsMetric, len(req.GetMetrics()))}
+	for i, m := range req.GetMetrics() {
+		q.Metrics[i] = internal.VehicleStatsMetric{Aggregate: m.GetAggregate(), Attribute: m.GetAttribute()}
+	}
+
+	stats, err := hd.sv.CalculateStats(q)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &vehiclev1.CalculateStatsResponse{Stats: make([]*vehiclev1.Stats, len(stats))}
+	for i, s := range stats {
+		res.Stats[i] = &vehiclev1.Stats{Group: s.Group, Count: int64(s.Count), Values: s.Values}
+	}
+	return res, nil
+}
+
+func (hd *VehicleGRPC) CalculateHistogram(ctx context.Context, req *vehiclev1.CalculateHistogramRequest) (*vehiclev1.CalculateHistogramResponse, error) {
+	histograms, err := hd.sv.CalculateHistogram(internal.VehicleHistogramQuery{
+		Attribute: req.GetAttribute(),
+		Buckets:   int(req.GetBuckets()),
+		Edges:     req.GetEdges(),
+		Filter:    req.GetFilter(),
+		GroupBy:   req.GetGroupBy(),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &vehiclev1.CalculateHistogramResponse{Histograms: make([]*vehiclev1.Histogram, len(histograms))}
+	for i, h := range histograms {
+		buckets := make([]*vehiclev1.HistogramBucket, len(h.Buckets))
+		for j, b := range h.Buckets {
+			buckets[j] = &vehiclev1.HistogramBucket{Min: b.Min, Max: b.Max, Count: int64(b.Count)}
+		}
+		res.Histograms[i] = &vehiclev1.Histogram{Group: h.Group, Buckets: buckets}
+	}
+	return res, nil
+}
+
+func (hd *VehicleGRPC) Search(req *vehiclev1.SearchRequest, stream vehiclev1.VehicleService_SearchServer) error {
+	results, err := hd.sv.Search(req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return grpcError(err)
+	}
+	for _, r := range results {
+		if err = stream.Send(&vehiclev1.SearchResult{Vehicle: serializeVehicleProto(r.Vehicle), Score: r.Score}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (hd *VehicleGRPC) FindAllSimilar(req *vehiclev1.FindAllSimilarRequest, stream vehiclev1.VehicleService_FindAllSimilarServer) error {
+	results, err := hd.sv.FindAllSimilar(internal.VehicleSimilarQuery{
+		ID:      int(req.GetId()),
+		Weights: req.GetWeights(),
+		Limit:   int(req.GetLimit()),
+	})
+	if err != nil {
+		return grpcError(err)
+	}
+	for _, r := range results {
+		if err = stream.Send(&vehiclev1.SimilarResult{Vehicle: serializeVehicleProto(r.Vehicle), Distance: r.Distance}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// intervalProto returns the interval between the optional bounds of a request, both included as in every rpc with ranges.
+// A bound that is not set leaves the interval open-ended on its side.
+func intervalProto[T int32 | float64](min, max *T) (in internal.Interval) {
+	if min != nil {
+		in.Min = &internal.Bound{Value: float64(*min), Inclusive: true}
+	}
+	if max != nil {
+		in.Max = &internal.Bound{Value: float64(*max), Inclusive: true}
+	}
+	return
+}
+
+// vehicleSender is the server stream of the rpcs that list vehicles.
+type vehicleSender interface {
+	Send(*vehiclev1.Vehicle) error
+}
+
+// sendVehiclesProto sends the vehicles found by the service to a server stream.
+func sendVehiclesProto(stream vehicleSender, v []internal.Vehicle, err error) error {
+	if err != nil {
+		return grpcError(err)
+	}
+	for _, vh := range v {
+		if err = stream.Send(serializeVehicleProto(vh)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serializeVehicleProto returns the protobuf message of a vehicle.
+func serializeVehicleProto(v internal.Vehicle) *vehiclev1.Vehicle {
+	return &vehiclev1.Vehicle{
+		Id: int64(v.ID),
+		Attributes: &vehiclev1.VehicleAttributes{
+			Brand:             v.Attributes.Brand,
+			Model:             v.Attributes.Model,
+			Registration:      v.Attributes.Registration,
+			Year:              int32(v.Attributes.Year),
+			Color:             v.Attributes.Color,
+			MaxSpeed:          int32(v.Attributes.MaxSpeed),
+			FuelType:          v.Attributes.FuelType,
+			Transmission:      v.Attributes.Transmission,
+			Passengers:        int32(v.Attributes.Passengers),
+			Height:            v.Attributes.Height,
+			Width:             v.Attributes.Width,
+			Weight:            v.Attributes.Weight,
+			BatteryCapacity:   v.Attributes.BatteryCapacity,
+			Range:             int32(v.Attributes.Range),
+			ChargingConnector: v.Attributes.ChargingConnector,
+		},
+		OwnerId: int64(v.OwnerID),
+		Source:  v.Source,
+	}
+}
+
+// deserializeVehicleProto returns the vehicle of the protobuf attributes, without id.
+func deserializeVehicleProto(a *vehiclev1.VehicleAttributes) internal.Vehicle {
+	return internal.Vehicle{
+		Attributes: internal.VehicleAttributes{
+			Brand:             a.GetBrand(),
+			Model:             a.GetModel(),
+			Registration:      a.GetRegistration(),
+			Year:              int(a.GetYear()),
+			Color:             a.GetColor(),
+			MaxSpeed:          int(a.GetMaxSpeed()),
+			FuelType:          a.GetFuelType(),
+			Transmission:      a.GetTransmission(),
+			Passengers:        int(a.GetPassengers()),
+			Height:            a.GetHeight(),
+			Width:             a.GetWidth(),
+			Weight:            a.GetWeight(),
+			BatteryCapacity:   a.GetBatteryCapacity(),
+			Range:             int(a.GetRange()),
+			ChargingConnector: a.GetChargingConnector(),
+		},
+	}
+}
+
+// grpcError maps an error of the vehicle service to a grpc status.
+// Errors that already are a status (e.g. of a failed send) are kept.
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, internal.ErrServiceVehicleNotFound):
+		return status.Error(codes.NotFound, "vehicle not found")
+	case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+		return status.Error(codes.NotFound, "vehicles not found")
+	case errors.Is(err, internal.ErrServiceVehicleIdAlreadyExists):
+		return status.Error(codes.AlreadyExists, "vehicle already exists")
+	}
+	if msg, _, ok := invalidVehicleMessage(err); ok {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	if msg, _, ok := invalidQueryMessage(err); ok {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return status.Error(codes.Internal, "an unexpected error occurred")
+}
@@ -0,0 +1,295 @@
+package handler
+
+import (
+	vehiclev1 "app/proto/vehicle/v1"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestGRPCClient returns a client of the grpc vehicle service over the test vehicles, served in memory.
+func newTestGRPCClient(t *testing.T) vehiclev1.VehicleServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	NewVehicleGRPC(newTestService()).Register(s)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return vehiclev1.NewVehicleServiceClient(conn)
+}
+
+// vehicleReceiver is the client stream of the rpcs that list vehicles.
+type vehicleReceiver interface {
+	Recv() (*vehiclev1.Vehicle, error)
+}
+
+// receiveIds returns the ids of the vehicles of a stream until it ends, and the error it ends with.
+func receiveIds(stream vehicleReceiver) (ids []int64, err error) {
+	for {
+		var v *vehiclev1.Vehicle
+		if v, err = stream.Recv(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return
+		}
+		ids = append(ids, v.GetId())
+	}
+}
+
+func TestVehicleGRPC_Unary(t *testing.T) {
+	c := newTestGRPCClient(t)
+	ctx := context.Background()
+
+	// insert
+	v, err := c.Insert(ctx, &vehiclev1.InsertRequest{Attributes: &vehiclev1.VehicleAttributes{
+		Brand: "Tesla", Model: "Model 3", Registration: "DDD444", Year: 2022, Color: "Black", MaxSpeed: 225,
+		FuelType: "electric", Transmission: "automatic", Passengers: 5, Height: 144, Width: 185, Weight: 1800,
+		BatteryCapacity: 75, Range: 500, ChargingConnector: "nacs",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.GetId() != 4 || v.GetAttributes().GetChargingConnector() != "NACS" {
+		t.Errorf("inserted = %v", v)
+	}
+
+	// update
+	v, err = c.UpdateMaxSpeedById(ctx, &vehiclev1.UpdateMaxSpeedByIdRequest{Id: 4, MaxSpeed: 230})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.GetAttributes().GetMaxSpeed() != 230 {
+		t.Errorf("max speed = %d, want 230", v.GetAttributes().GetMaxSpeed())
+	}
+
+	// average
+	avg, err := c.CalculateAverageSpeedByBrand(ctx, &vehiclev1.CalculateAverageByBrandRequest{Brand: "Ford"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avg.GetAverage() != 225 {
+		t.Errorf("average = %v, want 225", avg.GetAverage())
+	}
+
+	// delete
+	if _, err = c.Delete(ctx, &vehiclev1.DeleteRequest{Id: 4}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Delete(ctx, &vehiclev1.DeleteRequest{Id: 4})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestVehicleGRPC_UnaryErrors(t *testing.T) {
+	c := newTestGRPCClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+		msg  string
+	}{
+		{"not found", func() error {
+			_, err := c.UpdateFuelTypeById(ctx, &vehiclev1.UpdateFuelTypeByIdRequest{Id: 99, FuelType: "diesel"})
+			return err
+		}, codes.NotFound, "vehicle not found"},
+		{"invalid", func() error {
+			_, err := c.UpdateMaxSpeedById(ctx, &vehiclev1.UpdateMaxSpeedByIdRequest{Id: 1, MaxSpeed: -1})
+			return err
+		}, codes.InvalidArgument, "invalid vehicle max speed"},
+		{"without vehicles", func() error {
+			_, err := c.CalculateAverageCapacityByBrand(ctx, &vehiclev1.CalculateAverageByBrandRequest{Brand: "Tesla"})
+			return err
+		}, codes.NotFound, "vehicles not found"},
+		{"invalid owner", func() error {
+			_, err := c.UpdateOwnerById(ctx, &vehiclev1.UpdateOwnerByIdRequest{Id: 1, OwnerId: 5})
+			return err
+		}, codes.InvalidArgument, "invalid vehicle owner"},
+		{"invalid query", func() error {
+			_, err := c.CalculateStats(ctx, &vehiclev1.CalculateStatsRequest{Metrics: []*vehiclev1.StatsMetric{{Aggregate: "avg", Attribute: "color"}}})
+			return err
+		}, codes.InvalidArgument, "invalid metric"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, _ := status.FromError(tt.call())
+			if st.Code() != tt.code || st.Message() != tt.msg {
+				t.Errorf("status = %v %q, want %v %q", st.Code(), st.Message(), tt.code, tt.msg)
+			}
+		})
+	}
+}
+
+func TestVehicleGRPC_Aggregations(t *testing.T) {
+	c := newTestGRPCClient(t)
+	ctx := context.Background()
+
+	stats, err := c.CalculateStats(ctx, &vehiclev1.CalculateStatsRequest{
+		GroupBy: []string{"brand"},
+		Metrics: []*vehiclev1.StatsMetric{{Aggregate: "avg", Attribute: "max_speed"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]float64)
+	for _, s := range stats.GetStats() {
+		got[s.GetGroup()[0]] = s.GetValues()[0]
+	}
+	if len(got) != 2 || got["Ford"] != 225 || got["Nissan"] != 150 {
+		t.Errorf("stats = %v", stats.GetStats())
+	}
+
+	histograms, err := c.CalculateHistogram(ctx, &vehiclev1.CalculateHistogramRequest{Attribute: "weight", Edges: []float64{1000, 1500, 2000}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := histograms.GetHistograms(); len(h) != 1 || len(h[0].GetBuckets()) != 2 || h[0].GetBuckets()[0].GetCount() != 1 || h[0].GetBuckets()[1].GetCount() != 2 {
+		t.Errorf("histograms = %v", h)
+	}
+}
+
+func TestVehicleGRPC_UpdateOwnerById(t *testing.T) {
+	c := newTestGRPCClient(t)
+
+	v, err := c.UpdateOwnerById(context.Background(), &vehiclev1.UpdateOwnerByIdRequest{Id: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.GetId() != 1 || v.GetOwnerId() != 0 {
+		t.Errorf("vehicle = %v", v)
+	}
+}
+
+func TestVehicleGRPC_ServerStreaming(t *testing.T) {
+	c := newTestGRPCClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		open func() (vehicleReceiver, error)
+		ids  []int64
+		code codes.Code
+	}{
+		{"all", func() (vehicleReceiver, error) {
+			return c.FindAll(ctx, &vehiclev1.FindAllRequest{})
+		}, []int64{1, 2, 3}, codes.OK},
+		{"by fuel type", func() (vehicleReceiver, error) {
+			return c.FindAllByFuelType(ctx, &vehiclev1.FindAllByFuelTypeRequest{FuelType: "gasoline"})
+		}, []int64{1, 2}, codes.OK},
+		{"by weight", func() (vehicleReceiver, error) {
+			return c.FindAllByWeight(ctx, &vehiclev1.FindAllByWeightRequest{MinWeight: proto.Float64(1400), MaxWeight: proto.Float64(1700)})
+		}, []int64{2, 3}, codes.OK},
+		{"by weight with an upper bound", func() (vehicleReceiver, error) {
+			return c.FindAllByWeight(ctx, &vehiclev1.FindAllByWeightRequest{MaxWeight: proto.Float64(1500)})
+		}, []int64{1, 3}, codes.OK},
+		{"by brand from a year", func() (vehicleReceiver, error) {
+			return c.FindAllByBrandAndBetweenYears(ctx, &vehiclev1.FindAllByBrandAndBetweenYearsRequest{Brand: "Ford", StartYear: proto.Int32(2016)})
+		}, []int64{2}, codes.OK},
+		{"by dimensions with a lower height", func() (vehicleReceiver, error) {
+			return c.FindAllByDimensions(ctx, &vehiclev1.FindAllByDimensionsRequest{MinHeight: proto.Float64(150)})
+		}, []int64{1, 3}, codes.OK},
+		{"by charging connector", func() (vehicleReceiver, error) {
+			return c.FindAllByChargingConnector(ctx, &vehiclev1.FindAllByChargingConnectorRequest{ChargingConnector: "chademo"})
+		}, []int64{3}, codes.OK},
+		{"by range with a lower bound", func() (vehicleReceiver, error) {
+			return c.FindAllByRange(ctx, &vehiclev1.FindAllByRangeRequest{MinRange: proto.Int32(200)})
+		}, []int64{3}, codes.OK},
+		{"by range with an upper bound", func() (vehicleReceiver, error) {
+			return c.FindAllByRange(ctx, &vehiclev1.FindAllByRangeRequest{MaxRange: proto.Int32(100)})
+		}, nil, codes.NotFound},
+		{"by invalid range", func() (vehicleReceiver, error) {
+			return c.FindAllByRange(ctx, &vehiclev1.FindAllByRangeRequest{MinRange: proto.Int32(300), MaxRange: proto.Int32(200)})
+		}, nil, codes.InvalidArgument},
+		{"not found", func() (vehicleReceiver, error) {
+			return c.FindAllByTransmission(ctx, &vehiclev1.FindAllByTransmissionRequest{Transmission: "semi-automatic"})
+		}, nil, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := tt.open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids, err := receiveIds(stream)
+			if status.Code(err) != tt.code {
+				t.Fatalf("code = %v, want %v", status.Code(err), tt.code)
+			}
+			if !slices.Equal(ids, tt.ids) {
+				t.Errorf("ids = %v, want %v", ids, tt.ids)
+			}
+		})
+	}
+}
+
+func TestVehicleGRPC_SearchAndSimilar(t *testing.T) {
+	c := newTestGRPCClient(t)
+	ctx := context.Background()
+
+	search, err := c.Search(ctx, &vehiclev1.SearchRequest{Query: "mustang"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for {
+		r, err := search.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.GetScore() <= 0 {
+			t.Errorf("score = %v", r.GetScore())
+		}
+		ids = append(ids, r.GetVehicle().GetId())
+	}
+	if !slices.Equal(ids, []int64{2}) {
+		t.Errorf("search ids = %v, want [2]", ids)
+	}
+
+	similar, err := c.FindAllSimilar(ctx, &vehiclev1.FindAllSimilarRequest{Id: 1, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := similar.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetVehicle().GetId() != 2 || r.GetDistance() <= 0 || r.GetDistance() > 1 {
+		t.Errorf("similar = %v", r)
+	}
+	if _, err = similar.Recv(); !errors.Is(err, io.EOF) {
+		t.Errorf("err = %v, want the end of the stream", err)
+	}
+
+	similar, err = c.FindAllSimilar(ctx, &vehiclev1.FindAllSimilarRequest{Id: 1, Limit: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = similar.Recv()
+	if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument || st.Message() != "invalid similar limit" {
+		t.Errorf("status = %v", st)
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VehicleSearchResultJSON is an struct that represents a vehicle found by a search in json format.
+// The fields of the vehicle are embedded so the results can be written as flat records.
+type VehicleSearchResultJSON struct {
+	internal.VehicleJSON `yaml:",inline"`
+	Score                float64 `json:"score" xml:"score" yaml:"score"`
+}
+
+// serializeVehicleSearchResult returns the json representation of a vehicle found by a search,
+// with the dimensions and weight in the units of us.
+func serializeVehicleSearchResult(r internal.VehicleSearchResult, us internal.UnitSystem) VehicleSearchResultJSON {
+	return VehicleSearchResultJSON{
+		VehicleJSON: internal.SerializeVehicle(r.Vehicle, us),
+		Score:       r.Score,
+	}
+}
+
+// Search returns the vehicles matching the words of the q query param in the brand, model, color or registration,
+// case-insensitive and with typos (e.g. chevy, land cruiser), from the most to the least relevant.
+// The limit query param is the max number of vehicles (20 by default, 0 for all of them).
+func (hd *VehicleDefault) Search() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		// - units of the dimensions and weights of the response
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+		limit := 20
+		if l := ctx.Query("limit"); l != "" {
+			var err error
+			if limit, err = strconv.Atoi(l); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit", "code": "invalid_search_limit"})
+				return
+			}
+		}
+
+		// process
+		results, err := hd.sv.Search(ctx.Query("q"), limit)
+		if err != nil {
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidSearchQuery):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid query", "code": "invalid_search_query"})
+			case errors.Is(err, internal.ErrServiceInvalidSearchLimit):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit", "code": "invalid_search_limit"})
+			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicles not found", "code": "vehicles_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
+			return
+		}
+
+		// response
+		data := make([]VehicleSearchResultJSON, len(results))
+		for i, r := range results {
+			data[i] = serializeVehicleSearchResult(r, us)
+		}
+		respond(ctx, hd.enc, http.StatusOK, Envelope{Message: "success to search vehicles", Data: data})
+	}
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VehicleSimilarJSON is an struct that represents a vehicle like another one in json format.
+// The fields of the vehicle are embedded so the results can be written as flat records.
+type VehicleSimilarJSON struct {
+	internal.VehicleJSON `yaml:",inline"`
+	Distance             float64 `json:"distance" xml:"distance" yaml:"distance"`
+}
+
+// serializeVehicleSimilar returns the json representation of a vehicle like another one,
+// with the dimensions and weight in the units of us and the distance rounded to 4 decimals.
+func serializeVehicleSimilar(r internal.VehicleSimilarResult, us internal.UnitSystem) VehicleSimilarJSON {
+	return VehicleSimilarJSON{
+		VehicleJSON: internal.SerializeVehicle(r.Vehicle, us),
+		Distance:    math.Round(r.Distance*1e4) / 1e4,
+	}
+}
+
+// GetAllSimilar returns the other vehicles from the most to the least like the vehicle of the id param,
+// by a weighted distance over brand, year, passengers, transmission, fuel type and dimensions.
+// The weights query param replaces the weights of some attributes as attribute:weight (e.g. brand:0,year:5)
+// and the limit query param is the max number of vehicles (5 by default, 0 for all of them).
+func (hd *VehicleDefault) GetAllSimilar() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		// - units of the dimensions and weights of the response
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+		q := internal.VehicleSimilarQuery{Weights: make(map[string]float64), Limit: 5}
+		var err error
+		if q.ID, err = strconv.Atoi(ctx.Param("id")); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		for _, w := range splitQuery(ctx.Query("weights")) {
+			name, value, _ := strings.Cut(w, ":")
+			weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid weights", "code": "invalid_similar_weights"})
+				return
+			}
+			q.Weights[strings.TrimSpace(name)] = weight
+		}
+		if l := ctx.Query("limit"); l != "" {
+			if q.Limit, err = strconv.Atoi(l); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid similar limit", "code": "invalid_similar_limit"})
+				return
+			}
+		}
+
+		// process
+		results, err := hd.sv.FindAllSimilar(q)
+		if err != nil {
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidSimilarWeights):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid weights", "code": "invalid_similar_weights"})
+			case errors.Is(err, internal.ErrServiceInvalidSimilarLimit):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid similar limit", "code": "invalid_similar_limit"})
+			case errors.Is(err, internal.ErrServiceVehicleNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicle not found", "code": "vehicle_not_found"})
+			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "there are not any other vehicles", "code": "vehicles_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
+			return
+		}
+
+		// response
+		data := make([]VehicleSimilarJSON, len(results))
+		for i, r := range results {
+			data[i] = serializeVehicleSimilar(r, us)
+		}
+		respond(ctx, hd.enc, http.StatusOK, Envelope{Message: "similar vehicles were found", Data: data})
+	}
+}
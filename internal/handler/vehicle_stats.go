@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetStats returns metrics of the vehicles grouped by categorical attributes, computed in a single pass.
+// Groups are given in the group_by query param (e.g. brand,fuel_type) and metrics in the metrics query param
+// as aggregate:attribute (e.g. avg:max_speed,p95:weight,count), count if there are not any.
+// Each item of the data has the values of the group and a field per metric, named aggregate_attribute.
+// The metrics of the height, width and weight are in the units query param.
+func (hd *VehicleDefault) GetStats() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+		q := internal.VehicleStatsQuery{GroupBy: splitQuery(ctx.Query("group_by"))}
+		seen := make(map[string]bool)
+		for _, m := range splitQuery(ctx.Query("metrics")) {
+			aggregate, attribute, _ := strings.Cut(m, ":")
+			metric := internal.VehicleStatsMetric{Aggregate: strings.TrimSpace(aggregate), Attribute: strings.TrimSpace(attribute)}
+			// - repeated metrics would have repeated fields
+			if seen[metric.Name()] {
+				continue
+			}
+			seen[metric.Name()] = true
+			q.Metrics = append(q.Metrics, metric)
+		}
+		if len(q.Metrics) == 0 {
+			q.Metrics = []internal.VehicleStatsMetric{{Aggregate: "count"}}
+		}
+
+		// process
+		stats, err := hd.sv.CalculateStats(q)
+		if err != nil {
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidStatsGroupBy):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid group by", "code": "invalid_stats_group_by"})
+			case errors.Is(err, internal.ErrServiceInvalidStatsMetric):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid metric", "code": "invalid_stats_metric"})
+			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicles not found", "code": "vehicles_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
+			return
+		}
+
+		// response
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicle stats were calculated",
+			Data:    serializeVehicleStats(q, stats, us),
+		})
+	}
+}
+
+// recordField is an struct that represents a field of the flat records of a response.
+type recordField struct {
+	// Name is the name of the field in every encoding.
+	Name string
+	// Type is the type of the field.
+	Type reflect.Type
+}
+
+// newRecords returns a slice of n structs with the given fields, so every encoder (including csv and xml)
+// writes them as flat records. Fields are set by index with the reflect api.
+func newRecords(fields []recordField, n int) reflect.Value {
+	sf := make([]reflect.StructField, len(fields))
+	for i, f := range fields {
+		sf[i] = reflect.StructField{
+			Name: fmt.Sprintf("F%d", i),
+			Type: f.Type,
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:"%[1]s" xml:"%[1]s" yaml:"%[1]s"`, f.Name)),
+		}
+	}
+	return reflect.MakeSlice(reflect.SliceOf(reflect.StructOf(sf)), n, n)
+}
+
+// attributeScale returns the factor that converts a value of a numeric attribute from the unit it is stored in
+// to the one of us, 1 if the attribute has no unit.
+func attributeScale(attribute string, us internal.UnitSystem) float64 {
+	switch attribute {
+	case "height", "width":
+		return internal.VehicleLengthUnit.Convert(1, us.Length())
+	case "weight":
+		return internal.VehicleWeightUnit.Convert(1, us.Weight())
+	default:
+		return 1
+	}
+}
+
+// serializeVehicleStats returns the stats as flat records with a field per group attribute and metric,
+// the metrics of the height, width and weight in the units of us.
+func serializeVehicleStats(q internal.VehicleStatsQuery, stats []internal.VehicleStats, us internal.UnitSystem) any {
+	// type of the records
+	fields := make([]recordField, 0, len(q.GroupBy)+len(q.Metrics))
+	for _, g := range q.GroupBy {
+		fields = append(fields, recordField{Name: g, Type: reflect.TypeOf("")})
+	}
+	for _, m := range q.Metrics {
+		if m.Aggregate == "count" {
+			fields = append(fields, recordField{Name: m.Name(), Type: reflect.TypeOf(0)})
+			continue
+		}
+		fields = append(fields, recordField{Name: m.Name(), Type: reflect.TypeOf(0.0)})
+	}
+
+	// records
+	data := newRecords(fields, len(stats))
+	for i, s := range stats {
+		record := data.Index(i)
+		for j, v := range s.Group {
+			record.Field(j).SetString(v)
+		}
+		for j, v := range s.Values {
+			f := record.Field(len(s.Group) + j)
+			if f.Kind() == reflect.Int {
+				f.SetInt(int64(v))
+				continue
+			}
+			f.SetFloat(v * attributeScale(q.Metrics[j].Attribute, us))
+		}
+	}
+	return data.Interface()
+}
+
+// histogramFilters are the query params the vehicles of a histogram can be filtered by.
+var histogramFilters = []string{"brand", "model", "color", "fuel_type", "transmission", "charging_connector", "year", "passengers"}
+
+// GetHistogram returns the distribution of a numeric attribute of the vehicles, in the field query param
+// (max_speed, year, height, width, weight or passengers). The buckets are given as a number of buckets of the same width
+// in the buckets query param (10 by default) or as ascending bounds in the edges query param (e.g. 0,1000,2000).
+// Vehicles can be filtered by the value of a categorical attribute (e.g. brand=Ford) and grouped as in the stats.
+// Each item of the data is a bucket of a group, with the values of the group, min, max and count.
+// The edges and the bounds of the buckets of the height, width and weight are in the units query param.
+func (hd *VehicleDefault) GetHistogram() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// request
+		us, ok := unitSystem(ctx)
+		if !ok {
+			return
+		}
+		q := internal.VehicleHistogramQuery{
+			Attribute: ctx.Query("field"),
+			Buckets:   10,
+			Filter:    make(map[string]string),
+			GroupBy:   splitQuery(ctx.Query("group_by")),
+		}
+		if b := ctx.Query("buckets"); b != "" {
+			buckets, err := strconv.Atoi(b)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid buckets", "code": "invalid_histogram_buckets"})
+				return
+			}
+			q.Buckets = buckets
+		}
+		for _, e := range splitQuery(ctx.Query("edges")) {
+			edge, err := strconv.ParseFloat(e, 64)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid buckets", "code": "invalid_histogram_buckets"})
+				return
+			}
+			q.Edges = append(q.Edges, edge/attributeScale(q.Attribute, us))
+		}
+		for _, f := range histogramFilters {
+			if v, ok := ctx.GetQuery(f); ok {
+				q.Filter[f] = v
+			}
+		}
+
+		// process
+		histograms, err := hd.sv.CalculateHistogram(q)
+		if err != nil {
+			switch {
+			case errors.Is(err, internal.ErrServiceInvalidHistogramAttribute):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid field", "code": "invalid_histogram_attribute"})
+			case errors.Is(err, internal.ErrServiceInvalidHistogramBuckets):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid buckets", "code": "invalid_histogram_buckets"})
+			case errors.Is(err, internal.ErrServiceInvalidHistogramFilter):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid filter", "code": "invalid_histogram_filter"})
+			case errors.Is(err, internal.ErrServiceInvalidHistogramGroupBy):
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid group by", "code": "invalid_histogram_group_by"})
+			case errors.Is(err, internal.ErrServiceVehiclesNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": "vehicles not found", "code": "vehicles_not_found"})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			}
+			return
+		}
+
+		// response
+		respond(ctx, hd.enc, http.StatusOK, Envelope{
+			Message: "vehicle histogram was calculated",
+			Data:    serializeVehicleHistogram(q, histograms, us),
+		})
+	}
+}
+
+// serializeVehicleHistogram returns the buckets of the histograms as flat records with a field per group attribute,
+// min, max and count. The bounds of the height, width and weight are in the units of us, rounded to hundredths.
+func serializeVehicleHistogram(q internal.VehicleHistogramQuery, histograms []internal.VehicleHistogram, us internal.UnitSystem) any {
+	scale := attributeScale(q.Attribute, us)
+	bound := func(v float64) float64 {
+		if scale == 1 {
+			return v
+		}
+		return math.Round(v*scale*100) / 100
+	}
+
+	// type of the records
+	fields := make([]recordField, 0, len(q.GroupBy)+3)
+	for _, g := range q.GroupBy {
+		fields = append(fields, recordField{Name: g, Type: reflect.TypeOf("")})
+	}
+	fields = append(fields,
+		recordField{Name: "min", Type: reflect.TypeOf(0.0)},
+		recordField{Name: "max", Type: reflect.TypeOf(0.0)},
+		recordField{Name: "count", Type: reflect.TypeOf(0)},
+	)
+
+	// records
+	n := 0
+	for _, h := range histograms {
+		n += len(h.Buckets)
+	}
+	data := newRecords(fields, n)
+	i := 0
+	for _, h := range histograms {
+		for _, b := range h.Buckets {
+			record := data.Index(i)
+			for j, v := range h.Group {
+				record.Field(j).SetString(v)
+			}
+			record.Field(len(h.Group)).SetFloat(bound(b.Min))
+			record.Field(len(h.Group) + 1).SetFloat(bound(b.Max))
+			record.Field(len(h.Group) + 2).SetInt(int64(b.Count))
+			i++
+		}
+	}
+	return data.Interface()
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestVehicleDefault_StatsUnits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hd := NewVehicleDefault(newTestService(), nil, nil)
+	rt := gin.New()
+	rt.GET("/vehicles/stats", hd.GetStats())
+	rt.GET("/vehicles/histogram", hd.GetHistogram())
+
+	tests := []struct {
+		name   string
+		target string
+		code   int
+		data   []map[string]float64
+	}{
+		{"stats in metric units", "/vehicles/stats?metrics=max:weight,min:height,avg:max_speed,count", http.StatusOK,
+			[]map[string]float64{{"max_weight": 1700, "min_height": 140, "avg_max_speed": 200, "count": 3}}},
+		{"stats in imperial units", "/vehicles/stats?metrics=max:weight,min:height,avg:max_speed,count&units=imperial", http.StatusOK,
+			[]map[string]float64{{"max_weight": 3747.86, "min_height": 55.12, "avg_max_speed": 200, "count": 3}}},
+		{"stats in invalid units", "/vehicles/stats?metrics=max:weight&units=nautical", http.StatusBadRequest, nil},
+		{"histogram in metric units", "/vehicles/histogram?field=weight&edges=1000,1400,2000", http.StatusOK,
+			[]map[string]float64{{"min": 1000, "max": 1400, "count": 1}, {"min": 1400, "max": 2000, "count": 2}}},
+		{"histogram in imperial units", "/vehicles/histogram?field=weight&edges=2800,3400,4000&units=imperial", http.StatusOK,
+			[]map[string]float64{{"min": 2800, "max": 3400, "count": 2}, {"min": 3400, "max": 4000, "count": 1}}},
+		{"histogram of an attribute without units", "/vehicles/histogram?field=year&edges=2010,2020,2030&units=imperial", http.StatusOK,
+			[]map[string]float64{{"min": 2010, "max": 2020, "count": 1}, {"min": 2020, "max": 2030, "count": 2}}},
+		{"histogram in invalid units", "/vehicles/histogram?field=weight&units=nautical", http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := httptest.NewRecorder()
+			rt.ServeHTTP(res, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if res.Code != tt.code {
+				t.Fatalf("response = %d %s, want %d", res.Code, res.Body.String(), tt.code)
+			}
+			if tt.data == nil {
+				return
+			}
+
+			var r struct {
+				Data []map[string]float64 `json:"data"`
+			}
+			if err := json.Unmarshal(res.Body.Bytes(), &r); err != nil {
+				t.Fatal(err)
+			}
+			if len(r.Data) != len(tt.data) {
+				t.Fatalf("data = %v, want %v", r.Data, tt.data)
+			}
+			for i, want := range tt.data {
+				for k, v := range want {
+					if got, ok := r.Data[i][k]; !ok || math.Abs(got-v) > 0.005 {
+						t.Errorf("data[%d][%s] = %v, want %v", i, k, got, v)
+					}
+				}
+			}
+		})
+	}
+}
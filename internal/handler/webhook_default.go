@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"app/internal"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookJSON is an struct that represents a webhook in json format.
+// The secret is only returned when the webhook is created.
+type WebhookJSON struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryJSON is an struct that represents an attempt to send an event in json format.
+type WebhookDeliveryJSON struct {
+	ID         int       `json:"id"`
+	Event      string    `json:"event"`
+	EventID    int64     `json:"event_id"`
+	Attempt    int       `json:"attempt"`
+	Success    bool      `json:"success"`
+	StatusCode int       `json:"status_code"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	Time       time.Time `json:"time"`
+}
+
+// WebhookDeadLetterJSON is an struct that represents an event that could not be sent in json format.
+type WebhookDeadLetterJSON struct {
+	ID        int             `json:"id"`
+	Event     string          `json:"event"`
+	EventID   int64           `json:"event_id"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempts  int             `json:"attempts"`
+	LastError string          `json:"last_error"`
+	Time      time.Time       `json:"time"`
+}
+
+// BodyRequestWebhook is an struct that represents the body to create or update a webhook.
+// Active defaults to true, and an empty secret is generated on creation and kept on update.
+type BodyRequestWebhook struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+}
+
+// attributes returns the webhook attributes of the body.
+func (b BodyRequestWebhook) attributes() internal.WebhookAttributes {
+	a := internal.WebhookAttributes{
+		URL:    b.URL,
+		Secret: b.Secret,
+		Events: make([]internal.WebhookEventType, len(b.Events)),
+		Active: b.Active == nil || *b.Active,
+	}
+	for i, e := range b.Events {
+		a.Events[i] = internal.WebhookEventType(e)
+	}
+	return a
+}
+
+// serializeWebhook returns the json representation of a webhook, without its secret.
+func serializeWebhook(w internal.Webhook) WebhookJSON {
+	wj := WebhookJSON{
+		ID:        w.ID,
+		URL:       w.Attributes.URL,
+		Events:    make([]string, len(w.Attributes.Events)),
+		Active:    w.Attributes.Active,
+		CreatedAt: w.CreatedAt,
+	}
+	for i, e := range w.Attributes.Events {
+		wj.Events[i] = string(e)
+	}
+	return wj
+}
+
+// NewWebhookDefault returns a new instance of a webhook handler.
+func NewWebhookDefault(sv internal.ServiceWebhook) *WebhookDefault {
+	return &WebhookDefault{sv: sv}
+}
+
+// WebhookDefault is an struct that contains handlers for webhooks.
+type WebhookDefault struct {
+	sv internal.ServiceWebhook
+}
+
+// GetAll returns all webhooks.
+func (hd *WebhookDefault) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		webhooks, err := hd.sv.FindAll()
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+			return
+		}
+
+		data := make([]WebhookJSON, len(webhooks))
+		for i, w := range webhooks {
+			data[i] = serializeWebhook(w)
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "webhooks found", "data": data})
+	}
+}
+
+// Get returns a webhook.
+func (hd *WebhookDefault) Get() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		w, err := hd.sv.FindById(id)
+		if err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "webhook found", "data": serializeWebhook(w)})
+	}
+}
+
+// Create creates a webhook, the response is the only one including its secret.
+func (hd *WebhookDefault) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var body BodyRequestWebhook
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		w, err := hd.sv.Insert(body.attributes())
+		if err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		data := serializeWebhook(w)
+		data.Secret = w.Attributes.Secret
+		ctx.JSON(http.StatusCreated, gin.H{"message": "webhook created", "data": data})
+	}
+}
+
+// Update replaces a webhook.
+func (hd *WebhookDefault) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		var body BodyRequestWebhook
+		if !bindStrict(ctx, &body) {
+			return
+		}
+
+		w, err := hd.sv.Update(id, body.attributes())
+		if err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"message": "webhook updated", "data": serializeWebhook(w)})
+	}
+}
+
+// Delete removes a webhook.
+func (hd *WebhookDefault) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		if err := hd.sv.Delete(id); err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// GetDeliveries returns the last attempts to send events to a webhook, newest first.
+func (hd *WebhookDefault) GetDeliveries() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		deliveries, err := hd.sv.FindDeliveries(id)
+		if err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		data := make([]WebhookDeliveryJSON, len(deliveries))
+		for i, d := range deliveries {
+			data[i] = WebhookDeliveryJSON{
+				ID:         d.ID,
+				Event:      string(d.Event),
+				EventID:    d.EventID,
+				Attempt:    d.Attempt,
+				Success:    d.Succeeded(),
+				StatusCode: d.StatusCode,
+				Error:      d.Error,
+				DurationMs: d.Duration.Milliseconds(),
+				Time:       d.Time,
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "webhook deliveries found", "data": data})
+	}
+}
+
+// GetDeadLetters returns the events that could not be sent to a webhook.
+func (hd *WebhookDefault) GetDeadLetters() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+
+		deadLetters, err := hd.sv.FindDeadLetters(id)
+		if err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		data := make([]WebhookDeadLetterJSON, len(deadLetters))
+		for i, dl := range deadLetters {
+			data[i] = WebhookDeadLetterJSON{
+				ID:        dl.ID,
+				Event:     string(dl.Event),
+				EventID:   dl.EventID,
+				Payload:   dl.Payload,
+				Attempts:  dl.Attempts,
+				LastError: dl.LastError,
+				Time:      dl.Time,
+			}
+		}
+		ctx.JSON(http.StatusOK, gin.H{"message": "webhook dead letters found", "data": data})
+	}
+}
+
+// RetryDeadLetter sends a dead letter again.
+func (hd *WebhookDefault) RetryDeadLetter() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid identifier", "code": "invalid_identifier"})
+			return
+		}
+		deadLetterId, err := strconv.Atoi(ctx.Param("dead_letter_id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid dead letter identifier", "code": "invalid_dead_letter_identifier"})
+			return
+		}
+
+		if err := hd.sv.RetryDeadLetter(id, deadLetterId); err != nil {
+			webhookErrorResponse(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusAccepted, gin.H{"message": "dead letter scheduled for delivery"})
+	}
+}
+
+// webhookErrorResponse writes the response for an error of the webhook service.
+func webhookErrorResponse(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, internal.ErrServiceInvalidWebhookURL):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook url", "code": "invalid_webhook_url"})
+	case errors.Is(err, internal.ErrServiceInvalidWebhookSecret):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook secret, it must have at least 16 characters", "code": "invalid_webhook_secret"})
+	case errors.Is(err, internal.ErrServiceInvalidWebhookEvents):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook events", "code": "invalid_webhook_events"})
+	case errors.Is(err, internal.ErrServiceWebhookNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "webhook not found", "code": "webhook_not_found"})
+	case errors.Is(err, internal.ErrServiceWebhookDeadLetterNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "dead letter not found", "code": "dead_letter_not_found"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "an unexpected error occurred", "code": "internal_error"})
+	}
+}
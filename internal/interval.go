@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidInterval is returned when an interval is not written as expected or its bounds are not in order.
+var ErrInvalidInterval = errors.New("interval: invalid interval")
+
+// Bound is a bound of an interval.
+type Bound struct {
+	// Value is the value of the bound.
+	Value float64
+	// Inclusive is true if the value itself is within the interval.
+	Inclusive bool
+}
+
+// Interval is a range of values, each of its bounds inclusive or exclusive.
+// A nil bound leaves the interval open-ended on its side, so the zero value holds every value.
+type Interval struct {
+	// Min is the lower bound.
+	Min *Bound
+	// Max is the upper bound.
+	Max *Bound
+}
+
+// ClosedInterval returns the interval between min and max, both included.
+func ClosedInterval(min, max float64) Interval {
+	return Interval{Min: &Bound{Value: min, Inclusive: true}, Max: &Bound{Value: max, Inclusive: true}}
+}
+
+// Contains returns true if the value is within the interval.
+func (in Interval) Contains(v float64) bool {
+	if in.Min != nil && (v < in.Min.Value || v == in.Min.Value && !in.Min.Inclusive) {
+		return false
+	}
+	if in.Max != nil && (v > in.Max.Value || v == in.Max.Value && !in.Max.Inclusive) {
+		return false
+	}
+	return true
+}
+
+// Intersect returns the interval of the values within both intervals, the tighter of the bounds on each side.
+func (in Interval) Intersect(o Interval) Interval {
+	return Interval{Min: tighterBound(in.Min, o.Min, true), Max: tighterBound(in.Max, o.Max, false)}
+}
+
+// tighterBound returns the bound of a and b that leaves out more values, both being lower bounds if lower.
+func tighterBound(a, b *Bound, lower bool) *Bound {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.Value == b.Value:
+		if !a.Inclusive {
+			return a
+		}
+		return b
+	case (a.Value > b.Value) == lower:
+		return a
+	default:
+		return b
+	}
+}
+
+// Valid returns true if the bounds are numbers and the interval is not empty.
+func (in Interval) Valid() bool {
+	if in.Min != nil && math.IsNaN(in.Min.Value) || in.Max != nil && math.IsNaN(in.Max.Value) {
+		return false
+	}
+	if in.Min == nil || in.Max == nil {
+		return true
+	}
+	return in.Min.Value < in.Max.Value || in.Min.Value == in.Max.Value && in.Min.Inclusive && in.Max.Inclusive
+}
+
+// String returns the interval in brackets, as read by ParseInterval (e.g. [100,200) or (,200]).
+func (in Interval) String() string {
+	var sb strings.Builder
+	if in.Min != nil && in.Min.Inclusive {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+	if in.Min != nil {
+		sb.WriteString(strconv.FormatFloat(in.Min.Value, 'f', -1, 64))
+	}
+	sb.WriteByte(',')
+	if in.Max != nil {
+		sb.WriteString(strconv.FormatFloat(in.Max.Value, 'f', -1, 64))
+	}
+	if in.Max != nil && in.Max.Inclusive {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+// intervalComparisons are the operators of the intervals with a single bound, longest first.
+var intervalComparisons = []string{">=", "<=", ">", "<"}
+
+// ParseInterval returns the interval written in s, each of its bounds read with parse. It can be written as:
+//   - min-max, both included (e.g. 100-200). Either side can be left empty to leave it open-ended (e.g. 100-),
+//     so the bounds of this form cannot be negative.
+//   - in brackets, [ and ] including the bound and ( and ) excluding it (e.g. [100,200) or [100,)).
+//   - a comparison with a single bound (e.g. >=100 or <200).
+func ParseInterval(s string, parse func(s string) (float64, error)) (in Interval, err error) {
+	s = strings.TrimSpace(s)
+	bound := func(s string, inclusive bool) (b *Bound, err error) {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return
+		}
+		v, err := parse(s)
+		if err != nil {
+			err = fmt.Errorf("%w: %v", ErrInvalidInterval, err)
+			return
+		}
+		b = &Bound{Value: v, Inclusive: inclusive}
+		return
+	}
+
+	switch {
+	case s == "":
+		err = fmt.Errorf("%w: it is empty", ErrInvalidInterval)
+		return
+	case s[0] == '[' || s[0] == '(':
+		last := s[len(s)-1]
+		if len(s) < 2 || last != ']' && last != ')' {
+			err = fmt.Errorf("%w: %q is not in brackets", ErrInvalidInterval, s)
+			return
+		}
+		lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+		if !ok {
+			err = fmt.Errorf("%w: %q has no comma between its bounds", ErrInvalidInterval, s)
+			return
+		}
+		if in.Min, err = bound(lower, s[0] == '['); err != nil {
+			return
+		}
+		if in.Max, err = bound(upper, last == ']'); err != nil {
+			return
+		}
+	case s[0] == '>' || s[0] == '<':
+		for _, op := range intervalComparisons {
+			rest, ok := strings.CutPrefix(s, op)
+			if !ok {
+				continue
+			}
+			var b *Bound
+			if b, err = bound(rest, strings.HasSuffix(op, "=")); err != nil {
+				return
+			}
+			if b == nil {
+				err = fmt.Errorf("%w: %q has no bound", ErrInvalidInterval, s)
+				return
+			}
+			if op[0] == '>' {
+				in.Min = b
+			} else {
+				in.Max = b
+			}
+			break
+		}
+	default:
+		lower, upper, ok := strings.Cut(s, "-")
+		if !ok {
+			err = fmt.Errorf("%w: %q is not written as min-max", ErrInvalidInterval, s)
+			return
+		}
+		if in.Min, err = bound(lower, true); err != nil {
+			return
+		}
+		if in.Max, err = bound(upper, true); err != nil {
+			return
+		}
+		if in.Min == nil && in.Max == nil {
+			err = fmt.Errorf("%w: %q has no bounds", ErrInvalidInterval, s)
+			return
+		}
+	}
+
+	if !in.Valid() {
+		err = fmt.Errorf("%w: %q is empty", ErrInvalidInterval, s)
+	}
+	return
+}
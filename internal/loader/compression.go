@@ -0,0 +1,52 @@
+package loader
+
+import (
+	"compress/gzip"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// decompress wraps the reader with the decompressor matching the extension of the path (.gz or .zst),
+// other files are returned as they are.
+func decompress(r io.Reader, path string) (rc io.ReadCloser, err error) {
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		rc, err = gzip.NewReader(r)
+	case strings.HasSuffix(path, ".zst"):
+		var dec *zstd.Decoder
+		dec, err = zstd.NewReader(r)
+		if err != nil {
+			return
+		}
+		rc = dec.IOReadCloser()
+	default:
+		rc = io.NopCloser(r)
+	}
+	return
+}
+
+// compress wraps the writer with the compressor matching the extension of the path (.gz or .zst),
+// closing the returned writer flushes the compressed data but does not close w.
+func compress(w io.Writer, path string) (wc io.WriteCloser, err error) {
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		wc = gzip.NewWriter(w)
+	case strings.HasSuffix(path, ".zst"):
+		wc, err = zstd.NewWriter(w)
+	default:
+		wc = nopWriteCloser{w}
+	}
+	return
+}
+
+// nopWriteCloser is an io.WriteCloser whose Close does nothing.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close does nothing.
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,140 @@
+package loader
+
+import (
+	"app/internal"
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// SchemaVersion is the current version of the format of the data files.
+// Files without a schema_version field are considered version 0.
+const SchemaVersion = 3
+
+// Migration upgrades the raw content of a data file one version.
+type Migration func(raw map[string]any) (err error)
+
+// Migrations is the chain of migrations, Migrations[i] upgrades a file from version i to version i+1.
+var Migrations = []Migration{
+	migrateV0ToV1,
+	migrateV1ToV2,
+	migrateV2ToV3,
+}
+
+// migrateV0ToV1 upgrades the unversioned files, computing the last id when it is missing.
+func migrateV0ToV1(raw map[string]any) (err error) {
+	if _, ok := raw["last_id"]; ok {
+		return
+	}
+
+	data, _ := raw["data"].([]any)
+	lastId := int64(0)
+	for _, item := range data {
+		vehicle, _ := item.(map[string]any)
+		id, ok := vehicle["id"].(json.Number)
+		if !ok {
+			continue
+		}
+		var n int64
+		n, err = id.Int64()
+		if err != nil {
+			return
+		}
+		if n > lastId {
+			lastId = n
+		}
+	}
+	raw["last_id"] = lastId
+
+	return
+}
+
+// canonicalAttributes are the normalizations of the attributes of the vehicles with canonical values, by field name.
+var canonicalAttributes = map[string]func(s string) string{
+	"brand":        internal.NormalizeBrand,
+	"color":        internal.NormalizeColor,
+	"fuel_type":    internal.NormalizeFuelType,
+	"transmission": internal.NormalizeTransmission,
+}
+
+// migrateV1ToV2 canonicalizes the brand, color, fuel type and transmission of the vehicles (e.g. gas is gasoline).
+func migrateV1ToV2(raw map[string]any) (err error) {
+	data, _ := raw["data"].([]any)
+	for _, item := range data {
+		vehicle, _ := item.(map[string]any)
+		for attr, normalize := range canonicalAttributes {
+			if v, ok := vehicle[attr].(string); ok {
+				vehicle[attr] = normalize(v)
+			}
+		}
+	}
+
+	return
+}
+
+// migrateV2ToV3 declares the units of the dimensions and weights of the vehicles, which were written without them.
+// They are the units of the domain (centimeters and kilograms), so the values are kept.
+func migrateV2ToV3(raw map[string]any) (err error) {
+	raw["units"] = map[string]any{
+		"length": string(internal.VehicleLengthUnit),
+		"weight": string(internal.VehicleWeightUnit),
+	}
+
+	return
+}
+
+// decodeLoadDataJSON decodes the content of a data file, applying the migrations it needs to reach the current version.
+// It also returns the version the content was stored in.
+func decodeLoadDataJSON(b []byte) (ld LoadDataJSON, version int, err error) {
+	// version of the file
+	var header struct {
+		SchemaVersion int `json:"schema_version"`
+	}
+	if err = json.Unmarshal(b, &header); err != nil {
+		return
+	}
+	version = header.SchemaVersion
+
+	switch {
+	case version < 0 || version > SchemaVersion:
+		err = fmt.Errorf("%w: %d", internal.ErrLoaderUnsupportedSchemaVersion, version)
+	case version == SchemaVersion:
+		err = json.Unmarshal(b, &ld)
+	default:
+		// migrate the raw content, numbers are kept as they are written
+		var raw map[string]any
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		if err = dec.Decode(&raw); err != nil {
+			return
+		}
+		for v := version; v < SchemaVersion; v++ {
+			if err = Migrations[v](raw); err != nil {
+				err = fmt.Errorf("loader: migrating schema version %d to %d: %w", v, v+1, err)
+				return
+			}
+		}
+		raw["schema_version"] = SchemaVersion
+
+		// decode the migrated content
+		var migrated []byte
+		migrated, err = json.Marshal(raw)
+		if err != nil {
+			return
+		}
+		err = json.Unmarshal(migrated, &ld)
+	}
+	if err != nil {
+		return
+	}
+
+	// units the vehicles can be converted from
+	switch {
+	case !ld.Units.Length.Valid():
+		err = fmt.Errorf("%w: %q is not a unit of length", internal.ErrInvalidUnit, ld.Units.Length)
+	case !ld.Units.Weight.Valid():
+		err = fmt.Errorf("%w: %q is not a unit of weight", internal.ErrInvalidUnit, ld.Units.Weight)
+	}
+
+	return
+}
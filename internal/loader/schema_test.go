@@ -0,0 +1,80 @@
+package loader
+
+import (
+	"app/internal"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeLoadDataJSON(t *testing.T) {
+	// vehicles written with aliases of the canonical values, and without units
+	vehicles := `[{"id": 3, "brand": "chevy", "color": "grey", "fuel_type": "Gas", "transmission": "Semi Auto", "height": 150, "weight": 1300},
+		{"id": 7, "brand": "VW", "color": "light blue", "fuel_type": "ev", "transmission": "stick", "height": 140, "weight": 1500}]`
+	canonical := []VehicleDataJSON{
+		{ID: 3, Brand: "Chevrolet", Color: "Gray", FuelType: "gasoline", Transmission: "semi-automatic", Height: 150, Weight: 1300},
+		{ID: 7, Brand: "Volkswagen", Color: "Light Blue", FuelType: "electric", Transmission: "manual", Height: 140, Weight: 1500},
+	}
+	written := []VehicleDataJSON{
+		{ID: 3, Brand: "chevy", Color: "grey", FuelType: "Gas", Transmission: "Semi Auto", Height: 150, Weight: 1300},
+		{ID: 7, Brand: "VW", Color: "light blue", FuelType: "ev", Transmission: "stick", Height: 140, Weight: 1500},
+	}
+	metric := UnitsJSON{Length: internal.LengthUnitCentimeter, Weight: internal.WeightUnitKilogram}
+
+	tests := []struct {
+		name    string
+		content string
+		ld      LoadDataJSON
+		version int
+		err     error
+	}{
+		{"v0 without last id", `{"data": ` + vehicles + `}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Data: canonical, LastId: 7, Units: metric}, 0, nil},
+		{"v0 with last id", `{"data": ` + vehicles + `, "last_id": 10}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Data: canonical, LastId: 10, Units: metric}, 0, nil},
+		{"v0 without vehicles", `{}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Units: metric}, 0, nil},
+		{"v1 keeps the last id missing", `{"schema_version": 1, "data": ` + vehicles + `}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Data: canonical, Units: metric}, 1, nil},
+		{"v2 is already canonical", `{"schema_version": 2, "data": ` + vehicles + `, "last_id": 7}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Data: written, LastId: 7, Units: metric}, 2, nil},
+		{"v2 units are replaced", `{"schema_version": 2, "data": [], "last_id": 0, "units": {"length": "in", "weight": "lb"}}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Data: []VehicleDataJSON{}, Units: metric}, 2, nil},
+		{"v3 is not migrated", `{"schema_version": 3, "data": ` + vehicles + `, "last_id": 7, "units": {"length": "in", "weight": "lb"}}`,
+			LoadDataJSON{SchemaVersion: SchemaVersion, Data: written, LastId: 7, Units: UnitsJSON{Length: internal.LengthUnitInch, Weight: internal.WeightUnitPound}}, 3, nil},
+
+		{"v3 without units", `{"schema_version": 3, "data": []}`, LoadDataJSON{}, 3, internal.ErrInvalidUnit},
+		{"v3 with an invalid length unit", `{"schema_version": 3, "units": {"length": "yd", "weight": "kg"}}`, LoadDataJSON{}, 3, internal.ErrInvalidUnit},
+		{"v3 with an invalid weight unit", `{"schema_version": 3, "units": {"length": "cm", "weight": "st"}}`, LoadDataJSON{}, 3, internal.ErrInvalidUnit},
+		{"a future version", `{"schema_version": 4}`, LoadDataJSON{}, 4, internal.ErrLoaderUnsupportedSchemaVersion},
+		{"a negative version", `{"schema_version": -1}`, LoadDataJSON{}, -1, internal.ErrLoaderUnsupportedSchemaVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld, version, err := decodeLoadDataJSON([]byte(tt.content))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if version != tt.version {
+				t.Errorf("version = %d, want %d", version, tt.version)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(ld, tt.ld) {
+				t.Errorf("data = %+v, want %+v", ld, tt.ld)
+			}
+		})
+	}
+
+	t.Run("invalid json", func(t *testing.T) {
+		if _, _, err := decodeLoadDataJSON([]byte(`{"data": `)); err == nil {
+			t.Error("err = nil, want a syntax error")
+		}
+	})
+	t.Run("an invalid id", func(t *testing.T) {
+		if _, _, err := decodeLoadDataJSON([]byte(`{"data": [{"id": 1.5}]}`)); err == nil {
+			t.Error("err = nil, want the migration to fail")
+		}
+	})
+}
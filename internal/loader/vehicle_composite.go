@@ -0,0 +1,168 @@
+package loader
+
+import (
+	"app/internal"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ConflictPolicy is the strategy used to resolve vehicles that share an id or a registration across sources.
+type ConflictPolicy int
+
+const (
+	// ConflictPolicyError fails the load on the first conflict.
+	ConflictPolicyError ConflictPolicy = iota
+	// ConflictPolicyKeepFirst keeps the vehicle from the source loaded first.
+	ConflictPolicyKeepFirst
+	// ConflictPolicyKeepLast keeps the vehicle from the source loaded last.
+	ConflictPolicyKeepLast
+	// ConflictPolicyRenumber assigns a new id to vehicles whose id is already taken,
+	// vehicles with a repeated registration are considered the same and the first one is kept.
+	ConflictPolicyRenumber
+)
+
+// ParseConflictPolicy returns the conflict policy for the given name (error, first, last or renumber).
+func ParseConflictPolicy(s string) (p ConflictPolicy, err error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "error":
+		p = ConflictPolicyError
+	case "first":
+		p = ConflictPolicyKeepFirst
+	case "last":
+		p = ConflictPolicyKeepLast
+	case "renumber":
+		p = ConflictPolicyRenumber
+	default:
+		err = fmt.Errorf("%w: %s", internal.ErrLoaderInvalidConflictPolicy, s)
+	}
+	return
+}
+
+// ExpandPaths returns the files matched by the given paths or glob patterns, in order and without duplicates.
+// Patterns that do not match any file are kept as they are, so the error surfaces when the file is opened.
+func ExpandPaths(patterns ...string) (paths []string, err error) {
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		var matches []string
+		matches, err = filepath.Glob(pattern)
+		if err != nil {
+			return
+		}
+		if len(matches) == 0 {
+			matches = []string{pattern}
+		}
+
+		for _, m := range matches {
+			if seen[m] {
+				continue
+			}
+			seen[m] = true
+			paths = append(paths, m)
+		}
+	}
+	return
+}
+
+// NewVehicleComposite returns a new instance of a loader that merges the vehicles of several loaders.
+func NewVehicleComposite(policy ConflictPolicy, loaders ...internal.Loader) *VehicleComposite {
+	return &VehicleComposite{
+		Policy:  policy,
+		Loaders: loaders,
+	}
+}
+
+// VehicleComposite is an struct that implements the Loader interface merging several loaders.
+type VehicleComposite struct {
+	// Policy is the strategy used to resolve conflicts on id or registration.
+	Policy ConflictPolicy
+	// Loaders are the sources of vehicles, in order of precedence.
+	Loaders []internal.Loader
+}
+
+// Load returns the vehicles of all loaders merged.
+func (l *VehicleComposite) Load() (d internal.LoadData, err error) {
+	// load every source
+	sources := make([]internal.LoadData, len(l.Loaders))
+	for i, ld := range l.Loaders {
+		sources[i], err = ld.Load()
+		if err != nil {
+			return
+		}
+
+		// - the last id has to cover every id of every source
+		if sources[i].LastId > d.LastId {
+			d.LastId = sources[i].LastId
+		}
+		for _, v := range sources[i].Data {
+			if v.ID > d.LastId {
+				d.LastId = v.ID
+			}
+		}
+	}
+
+	// merge
+	// - alive marks the vehicles that survived the conflicts, so replaced ones can be dropped at the end
+	// - origin is the source of each vehicle, repeated vehicles within the same source are not conflicts
+	merged := make([]internal.Vehicle, 0)
+	alive := make([]bool, 0)
+	origin := make([]int, 0)
+	byId := make(map[int]int)
+	byRegistration := make(map[string]int)
+	for s, source := range sources {
+		for _, v := range source.Data {
+			ixId, okId := byId[v.ID]
+			ixReg, okReg := byRegistration[v.Attributes.Registration]
+			okId = okId && alive[ixId] && origin[ixId] != s
+			okReg = okReg && alive[ixReg] && origin[ixReg] != s
+
+			if okId || okReg {
+				ix := ixId
+				if !okId {
+					ix = ixReg
+				}
+
+				switch l.Policy {
+				case ConflictPolicyKeepFirst:
+					continue
+				case ConflictPolicyKeepLast:
+					// drop every vehicle the new one collides with
+					alive[ix] = false
+					if okId && okReg {
+						alive[ixReg] = false
+					}
+				case ConflictPolicyRenumber:
+					if okReg {
+						continue
+					}
+					d.LastId++
+					v.ID = d.LastId
+				default:
+					err = fmt.Errorf("%w: vehicle %d (registration %s) from %s and %s", internal.ErrLoaderVehicleConflict, v.ID, v.Attributes.Registration, merged[ix].Source, v.Source)
+					return
+				}
+			}
+
+			merged = append(merged, v)
+			alive = append(alive, true)
+			origin = append(origin, s)
+			byId[v.ID] = len(merged) - 1
+			byRegistration[v.Attributes.Registration] = len(merged) - 1
+		}
+	}
+
+	// - keep the surviving vehicles
+	d.Data = make([]internal.Vehicle, 0, len(merged))
+	for i, v := range merged {
+		if alive[i] {
+			d.Data = append(d.Data, v)
+		}
+	}
+
+	return
+}
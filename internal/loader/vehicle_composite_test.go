@@ -0,0 +1,156 @@
+package loader
+
+import (
+	"app/internal"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// loaderStub is a loader that returns the given data, or err.
+type loaderStub struct {
+	data internal.LoadData
+	err  error
+}
+
+func (l loaderStub) Load() (internal.LoadData, error) {
+	return l.data, l.err
+}
+
+// stubVehicle returns a vehicle with an id and a registration, loaded from source.
+func stubVehicle(id int, registration string, source string) internal.Vehicle {
+	return internal.Vehicle{ID: id, Attributes: internal.VehicleAttributes{Registration: registration}, Source: source}
+}
+
+// stubVehicleKey is what tells the vehicles merged apart.
+type stubVehicleKey struct {
+	ID           int
+	Registration string
+	Source       string
+}
+
+func TestExpandPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.json", "b.json", "c.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	tests := []struct {
+		name     string
+		patterns []string
+		paths    []string
+		err      error
+	}{
+		{"a path", []string{path("c.txt")}, []string{path("c.txt")}, nil},
+		{"a pattern, in order", []string{path("*.json")}, []string{path("a.json"), path("b.json")}, nil},
+		{"without duplicates", []string{path("b.json"), path("*.json"), path("a.json")}, []string{path("b.json"), path("a.json")}, nil},
+		{"blank paths are skipped", []string{" ", path("c.txt") + " "}, []string{path("c.txt")}, nil},
+		{"not matched kept", []string{path("missing.json"), path("*.csv")}, []string{path("missing.json"), path("*.csv")}, nil},
+		{"invalid pattern", []string{path("[")}, nil, filepath.ErrBadPattern},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, err := ExpandPaths(tt.patterns...)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if !slices.Equal(paths, tt.paths) {
+				t.Errorf("paths = %v, want %v", paths, tt.paths)
+			}
+		})
+	}
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	tests := []struct {
+		s      string
+		policy ConflictPolicy
+		err    error
+	}{
+		{"", ConflictPolicyError, nil},
+		{"error", ConflictPolicyError, nil},
+		{" First ", ConflictPolicyKeepFirst, nil},
+		{"LAST", ConflictPolicyKeepLast, nil},
+		{"renumber", ConflictPolicyRenumber, nil},
+		{"newest", 0, internal.ErrLoaderInvalidConflictPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			p, err := ParseConflictPolicy(tt.s)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err == nil && p != tt.policy {
+				t.Errorf("policy = %v, want %v", p, tt.policy)
+			}
+		})
+	}
+}
+
+func TestVehicleComposite_Load(t *testing.T) {
+	// b repeats the id 2 and the registration AAA of a, with another registration and id
+	a := internal.LoadData{Data: []internal.Vehicle{stubVehicle(1, "AAA", "a"), stubVehicle(2, "BBB", "a")}, LastId: 2}
+	b := internal.LoadData{Data: []internal.Vehicle{stubVehicle(2, "CCC", "b"), stubVehicle(3, "AAA", "b"), stubVehicle(4, "DDD", "b")}, LastId: 4}
+	// c does not repeat any vehicle of a, but repeats one of its own, which is not a conflict
+	c := internal.LoadData{Data: []internal.Vehicle{stubVehicle(7, "GGG", "c"), stubVehicle(7, "GGG", "c")}, LastId: 9}
+
+	tests := []struct {
+		name     string
+		policy   ConflictPolicy
+		sources  []internal.LoadData
+		vehicles []stubVehicleKey
+		lastId   int
+		err      error
+	}{
+		{"without conflicts", ConflictPolicyError, []internal.LoadData{a, c},
+			[]stubVehicleKey{{1, "AAA", "a"}, {2, "BBB", "a"}, {7, "GGG", "c"}, {7, "GGG", "c"}}, 9, nil},
+		{"error", ConflictPolicyError, []internal.LoadData{a, b}, nil, 0, internal.ErrLoaderVehicleConflict},
+		{"first", ConflictPolicyKeepFirst, []internal.LoadData{a, b},
+			[]stubVehicleKey{{1, "AAA", "a"}, {2, "BBB", "a"}, {4, "DDD", "b"}}, 4, nil},
+		{"last", ConflictPolicyKeepLast, []internal.LoadData{a, b},
+			[]stubVehicleKey{{2, "CCC", "b"}, {3, "AAA", "b"}, {4, "DDD", "b"}}, 4, nil},
+		{"renumber", ConflictPolicyRenumber, []internal.LoadData{a, b},
+			[]stubVehicleKey{{1, "AAA", "a"}, {2, "BBB", "a"}, {5, "CCC", "b"}, {4, "DDD", "b"}}, 5, nil},
+		{"renumber after the last id of every source", ConflictPolicyRenumber, []internal.LoadData{a, c, b},
+			[]stubVehicleKey{{1, "AAA", "a"}, {2, "BBB", "a"}, {7, "GGG", "c"}, {7, "GGG", "c"}, {10, "CCC", "b"}, {4, "DDD", "b"}}, 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loaders := make([]internal.Loader, len(tt.sources))
+			for i, s := range tt.sources {
+				loaders[i] = loaderStub{data: s}
+			}
+
+			d, err := NewVehicleComposite(tt.policy, loaders...).Load()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			vehicles := make([]stubVehicleKey, len(d.Data))
+			for i, v := range d.Data {
+				vehicles[i] = stubVehicleKey{v.ID, v.Attributes.Registration, v.Source}
+			}
+			if !slices.Equal(vehicles, tt.vehicles) {
+				t.Errorf("vehicles = %v, want %v", vehicles, tt.vehicles)
+			}
+			if d.LastId != tt.lastId {
+				t.Errorf("last id = %d, want %d", d.LastId, tt.lastId)
+			}
+		})
+	}
+
+	t.Run("a source fails", func(t *testing.T) {
+		errLoad := errors.New("load failed")
+		_, err := NewVehicleComposite(ConflictPolicyKeepFirst, loaderStub{data: a}, loaderStub{err: errLoad}).Load()
+		if !errors.Is(err, errLoad) {
+			t.Errorf("err = %v, want %v", err, errLoad)
+		}
+	})
+}
@@ -2,28 +2,53 @@ package loader
 
 import (
 	"app/internal"
+	"bufio"
 	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 )
 
 // LoadDataJSON is an struct that represents the data of file.
 type LoadDataJSON struct {
-	Data []struct {
-		ID           int     `json:"id"`
-		Brand        string  `json:"brand"`
-		Model        string  `json:"model"`
-		Registration string  `json:"registration"`
-		Year         int     `json:"year"`
-		Color        string  `json:"color"`
-		MaxSpeed     int     `json:"max_speed"`
-		FuelType     string  `json:"fuel_type"`
-		Transmission string  `json:"transmission"`
-		Passengers   int     `json:"passengers"`
-		Height       float64 `json:"height"`
-		Width        float64 `json:"width"`
-		Weight       float64 `json:"weight"`
-	} `json:"data"`
-	LastId int `json:"last_id"`
+	// SchemaVersion is the version of the format of the file (see SchemaVersion).
+	SchemaVersion int               `json:"schema_version"`
+	Data          []VehicleDataJSON `json:"data"`
+	LastId        int               `json:"last_id"`
+	// Units are the units the dimensions and weights of the vehicles are written in.
+	Units UnitsJSON `json:"units"`
+}
+
+// UnitsJSON is an struct that represents the units of the vehicles of a file.
+type UnitsJSON struct {
+	// Length is the unit of the height and width.
+	Length internal.LengthUnit `json:"length"`
+	// Weight is the unit of the weight.
+	Weight internal.WeightUnit `json:"weight"`
+}
+
+// VehicleDataJSON is an struct that represents a vehicle in the file.
+// The owner of a vehicle is not part of it: owners are only kept in memory, so vehicles are loaded without one.
+type VehicleDataJSON struct {
+	ID           int     `json:"id"`
+	Brand        string  `json:"brand"`
+	Model        string  `json:"model"`
+	Registration string  `json:"registration"`
+	Year         int     `json:"year"`
+	Color        string  `json:"color"`
+	MaxSpeed     int     `json:"max_speed"`
+	FuelType     string  `json:"fuel_type"`
+	Transmission string  `json:"transmission"`
+	Passengers   int     `json:"passengers"`
+	Height       float64 `json:"height"`
+	Width        float64 `json:"width"`
+	Weight       float64 `json:"weight"`
+	// BatteryCapacity, Range and ChargingConnector are omitted for vehicles that are not electric or hybrid.
+	BatteryCapacity   float64 `json:"battery_capacity,omitempty"`
+	Range             int     `json:"range,omitempty"`
+	ChargingConnector string  `json:"charging_connector,omitempty"`
 }
 
 // NewVehicleJSON returns a new instance of a vehicle loader.
@@ -31,47 +56,53 @@ func NewVehicleJSON(path string) *VehicleJSON {
 	return &VehicleJSON{Path: path}
 }
 
+// NewVehicleJSONFS returns a new instance of a vehicle loader that reads the file from a file system (e.g. an embedded one).
+func NewVehicleJSONFS(fsys fs.FS, path string) *VehicleJSON {
+	return &VehicleJSON{FS: fsys, Path: path}
+}
+
 // VehicleJSON is an struct that implements the LoaderVehicle interface.
+// Files ending in .gz or .zst are decompressed transparently.
 type VehicleJSON struct {
+	// FS is the file system where the file is read from, the os one if nil.
+	FS fs.FS
+	// Path is the path to the file.
 	Path string
 }
 
 // Load returns all vehicles.
 func (l *VehicleJSON) Load() (d internal.LoadData, err error) {
-	// open file
-	f, err := os.Open(l.Path)
-	if err != nil {
-		return
-	}
-	defer f.Close()
-
 	// read file
-	var loadDataJSON LoadDataJSON
-	err = json.NewDecoder(f).Decode(&loadDataJSON)
+	loadDataJSON, _, err := l.read()
 	if err != nil {
 		return
 	}
 
 	// serialize load data
-	// - data
+	// - data, in the units of the domain
+	lu, wu := loadDataJSON.Units.Length, loadDataJSON.Units.Weight
 	d.Data = make([]internal.Vehicle, len(loadDataJSON.Data))
 	for i, vehicle := range loadDataJSON.Data {
 		d.Data[i] = internal.Vehicle{
-			ID:           vehicle.ID,
-			Attributes:   internal.VehicleAttributes{
-				Brand:        vehicle.Brand,
-				Model:        vehicle.Model,
-				Registration: vehicle.Registration,
-				Year:         vehicle.Year,
-				Color:        vehicle.Color,
-				MaxSpeed:     vehicle.MaxSpeed,
-				FuelType:     vehicle.FuelType,
-				Transmission: vehicle.Transmission,
-				Passengers:   vehicle.Passengers,
-				Height:       vehicle.Height,
-				Width:        vehicle.Width,
-				Weight:       vehicle.Weight,
+			ID: vehicle.ID,
+			Attributes: internal.VehicleAttributes{
+				Brand:             vehicle.Brand,
+				Model:             vehicle.Model,
+				Registration:      vehicle.Registration,
+				Year:              vehicle.Year,
+				Color:             vehicle.Color,
+				MaxSpeed:          vehicle.MaxSpeed,
+				FuelType:          vehicle.FuelType,
+				Transmission:      vehicle.Transmission,
+				Passengers:        vehicle.Passengers,
+				Height:            lu.Convert(vehicle.Height, internal.VehicleLengthUnit),
+				Width:             lu.Convert(vehicle.Width, internal.VehicleLengthUnit),
+				Weight:            wu.Convert(vehicle.Weight, internal.VehicleWeightUnit),
+				BatteryCapacity:   vehicle.BatteryCapacity,
+				Range:             vehicle.Range,
+				ChargingConnector: vehicle.ChargingConnector,
 			},
+			Source: l.Path,
 		}
 	}
 	// - last id
@@ -79,3 +110,152 @@ func (l *VehicleJSON) Load() (d internal.LoadData, err error) {
 
 	return
 }
+
+// Migrate upgrades the file in place to the current schema version, returning the version it had.
+// Files already in the current version are not rewritten.
+func (l *VehicleJSON) Migrate() (from int, err error) {
+	if l.FS != nil {
+		err = internal.ErrLoaderReadOnly
+		return
+	}
+
+	loadDataJSON, from, err := l.read()
+	if err != nil {
+		return
+	}
+	if from == SchemaVersion {
+		return
+	}
+
+	err = l.write(loadDataJSON)
+	return
+}
+
+// Save replaces the file with the vehicles, in the current schema version.
+// Their owners are not saved, as owners are only kept in memory.
+func (l *VehicleJSON) Save(d internal.LoadData) (err error) {
+	if l.FS != nil {
+		err = internal.ErrLoaderReadOnly
+		return
+	}
+
+	// deserialize load data
+	loadDataJSON := LoadDataJSON{
+		SchemaVersion: SchemaVersion,
+		Units:         UnitsJSON{Length: internal.VehicleLengthUnit, Weight: internal.VehicleWeightUnit},
+		Data:          make([]VehicleDataJSON, len(d.Data)),
+		LastId:        d.LastId,
+	}
+	for i, vehicle := range d.Data {
+		loadDataJSON.Data[i] = VehicleDataJSON{
+			ID:                vehicle.ID,
+			Brand:             vehicle.Attributes.Brand,
+			Model:             vehicle.Attributes.Model,
+			Registration:      vehicle.Attributes.Registration,
+			Year:              vehicle.Attributes.Year,
+			Color:             vehicle.Attributes.Color,
+			MaxSpeed:          vehicle.Attributes.MaxSpeed,
+			FuelType:          vehicle.Attributes.FuelType,
+			Transmission:      vehicle.Attributes.Transmission,
+			Passengers:        vehicle.Attributes.Passengers,
+			Height:            vehicle.Attributes.Height,
+			Width:             vehicle.Attributes.Width,
+			Weight:            vehicle.Attributes.Weight,
+			BatteryCapacity:   vehicle.Attributes.BatteryCapacity,
+			Range:             vehicle.Attributes.Range,
+			ChargingConnector: vehicle.Attributes.ChargingConnector,
+		}
+	}
+
+	err = l.write(loadDataJSON)
+	return
+}
+
+// read returns the content of the file migrated to the current schema version and the version it was stored in.
+func (l *VehicleJSON) read() (ld LoadDataJSON, version int, err error) {
+	// open file
+	var f io.ReadCloser
+	if l.FS != nil {
+		f, err = l.FS.Open(l.Path)
+	} else {
+		f, err = os.Open(l.Path)
+	}
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	// - decompress
+	r, err := decompress(f, l.Path)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	// read file
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	ld, version, err = decodeLoadDataJSON(b)
+	return
+}
+
+// write replaces the file with the given data, compressed according to its extension.
+func (l *VehicleJSON) write(ld LoadDataJSON) (err error) {
+	// write to a temporary file in the same directory, so the original is kept if anything fails
+	tmp, err := os.CreateTemp(filepath.Dir(l.Path), filepath.Base(l.Path)+".*.tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	// - keep the permissions of the original file
+	if info, e := os.Stat(l.Path); e == nil {
+		if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+			return
+		}
+	}
+
+	// - compress
+	w, err := compress(tmp, l.Path)
+	if err != nil {
+		return
+	}
+	if err = encodeLoadDataJSON(w, ld); err != nil {
+		return
+	}
+	if err = w.Close(); err != nil {
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+
+	// replace the file
+	err = os.Rename(tmp.Name(), l.Path)
+	return
+}
+
+// encodeLoadDataJSON writes the data with the layout of the sample files, one vehicle per line.
+func encodeLoadDataJSON(w io.Writer, ld LoadDataJSON) (err error) {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "{\n    \"schema_version\": %d,\n    \"units\": {\"length\": %q, \"weight\": %q},\n    \"data\": [\n",
+		ld.SchemaVersion, ld.Units.Length, ld.Units.Weight)
+	for i, v := range ld.Data {
+		var b []byte
+		b, err = json.Marshal(v)
+		if err != nil {
+			return
+		}
+		bw.WriteString("        ")
+		bw.Write(b)
+		if i < len(ld.Data)-1 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n")
+	}
+	fmt.Fprintf(bw, "    ],\n    \"last_id\": %d\n}\n", ld.LastId)
+	err = bw.Flush()
+	return
+}
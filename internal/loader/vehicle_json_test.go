@@ -0,0 +1,94 @@
+package loader
+
+import (
+	"app/internal"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestVehicleJSON_Migrate(t *testing.T) {
+	// a file of the version 1, with aliases of the canonical values
+	content := `{"schema_version": 1, "data": [
+		{"id": 1, "brand": "chevy", "model": "Bolt", "color": "grey", "fuel_type": "EV", "transmission": "auto", "height": 160, "weight": 1600},
+		{"id": 2, "brand": "ford", "model": "Focus", "color": "dark-blue", "fuel_type": "petrol", "transmission": "stick", "height": 148, "weight": 1300}
+	], "last_id": 2}`
+
+	for _, name := range []string{"vehicles.json", "vehicles.json.gz"} {
+		t.Run(name, func(t *testing.T) {
+			// - compressed according to its extension
+			path := filepath.Join(t.TempDir(), name)
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w, err := compress(f, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = w.Write([]byte(content)); err != nil {
+				t.Fatal(err)
+			}
+			if err = w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if err = f.Close(); err != nil {
+				t.Fatal(err)
+			}
+			l := NewVehicleJSON(path)
+
+			from, err := l.Migrate()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if from != 1 {
+				t.Errorf("from = %d, want 1", from)
+			}
+
+			// the file is rewritten in the current version, with the canonical values
+			ld, version, err := l.read()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version != SchemaVersion {
+				t.Errorf("version = %d, want %d", version, SchemaVersion)
+			}
+			want := []VehicleDataJSON{
+				{ID: 1, Brand: "Chevrolet", Model: "Bolt", Color: "Gray", FuelType: "electric", Transmission: "automatic", Height: 160, Weight: 1600},
+				{ID: 2, Brand: "Ford", Model: "Focus", Color: "Dark Blue", FuelType: "gasoline", Transmission: "manual", Height: 148, Weight: 1300},
+			}
+			if len(ld.Data) != len(want) || ld.Data[0] != want[0] || ld.Data[1] != want[1] || ld.LastId != 2 {
+				t.Errorf("data = %+v, last id = %d, want %+v", ld.Data, ld.LastId, want)
+			}
+			if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+				t.Errorf("file = %v, %v, want its permissions kept", info, err)
+			}
+
+			// - a file in the current version is not migrated again
+			if from, err = l.Migrate(); err != nil || from != SchemaVersion {
+				t.Errorf("from = %d, %v, want %d", from, err, SchemaVersion)
+			}
+		})
+	}
+
+	t.Run("read only", func(t *testing.T) {
+		fsys := fstest.MapFS{"vehicles.json": {Data: []byte(content)}}
+		if _, err := NewVehicleJSONFS(fsys, "vehicles.json").Migrate(); !errors.Is(err, internal.ErrLoaderReadOnly) {
+			t.Errorf("err = %v, want %v", err, internal.ErrLoaderReadOnly)
+		}
+	})
+	t.Run("an unsupported version is kept", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "vehicles.json")
+		if err := os.WriteFile(path, []byte(`{"schema_version": 9}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewVehicleJSON(path).Migrate(); !errors.Is(err, internal.ErrLoaderUnsupportedSchemaVersion) {
+			t.Errorf("err = %v, want %v", err, internal.ErrLoaderUnsupportedSchemaVersion)
+		}
+		if b, _ := os.ReadFile(path); string(b) != `{"schema_version": 9}` {
+			t.Errorf("content = %s, want it kept", b)
+		}
+	})
+}
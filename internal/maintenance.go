@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"strings"
+	"time"
+)
+
+// MaintenanceType is the kind of work done to a vehicle.
+type MaintenanceType string
+
+const (
+	// MaintenanceOilChange is a change of the engine oil.
+	MaintenanceOilChange MaintenanceType = "oil_change"
+	// MaintenanceInspection is a periodic inspection of the vehicle.
+	MaintenanceInspection MaintenanceType = "inspection"
+	// MaintenanceRepair is a repair, usually not scheduled.
+	MaintenanceRepair MaintenanceType = "repair"
+)
+
+// MaintenanceTypes are all the kinds of maintenance.
+var MaintenanceTypes = []MaintenanceType{MaintenanceOilChange, MaintenanceInspection, MaintenanceRepair}
+
+// MaintenanceAttributes is an struct that represents the attributes of a maintenance record.
+type MaintenanceAttributes struct {
+	// Date is the day the work was done, at midnight UTC.
+	Date time.Time
+	// Odometer is the distance the vehicle had travelled when the work was done, in km.
+	Odometer int
+	// Type is the kind of work.
+	Type MaintenanceType
+	// Cost is what the work cost.
+	Cost float64
+	// Notes are free text about the work, empty if there are not any.
+	Notes string
+}
+
+// MaintenanceRecord is an struct that represents a maintenance done to a vehicle.
+type MaintenanceRecord struct {
+	// ID is the unique identifier of the record.
+	ID int
+	// VehicleID is the id of the vehicle the work was done to.
+	VehicleID int
+	// Attributes is the attributes of the record.
+	Attributes MaintenanceAttributes
+	// CreatedAt is when the record was created.
+	CreatedAt time.Time
+}
+
+// MaintenanceInterval is an struct that represents how often a kind of maintenance is due,
+// for the vehicles of a brand and fuel type. It is due when either the months or the kilometers pass.
+type MaintenanceInterval struct {
+	// Brand is the brand of the vehicles, empty for every brand.
+	Brand string
+	// FuelType is the fuel type of the vehicles, empty for every fuel type.
+	FuelType string
+	// Type is the kind of maintenance.
+	Type MaintenanceType
+	// Months is the time between two of them, 0 if it is not due by time.
+	// With neither months nor kilometers, the vehicles do not need it (e.g. oil changes of electric vehicles).
+	Months int
+	// Kilometers is the distance between two of them, 0 if it is not due by distance.
+	Kilometers int
+}
+
+// Matches returns true if the interval applies to a vehicle.
+func (i MaintenanceInterval) Matches(a VehicleAttributes) bool {
+	return (i.Brand == "" || strings.EqualFold(i.Brand, a.Brand)) &&
+		(i.FuelType == "" || strings.EqualFold(i.FuelType, a.FuelType))
+}
+
+// Specificity returns how specific the interval is: a brand outweighs a fuel type, which outweighs neither.
+// Of the intervals of a kind of maintenance matching a vehicle, the most specific one applies.
+func (i MaintenanceInterval) Specificity() int {
+	var s int
+	if i.Brand != "" {
+		s += 2
+	}
+	if i.FuelType != "" {
+		s++
+	}
+	return s
+}
+
+// DefaultMaintenanceIntervals returns the intervals before any is configured:
+// oil changes every 12 months or 15000 km (none for electric vehicles) and inspections every 24 months.
+func DefaultMaintenanceIntervals() []MaintenanceInterval {
+	return []MaintenanceInterval{
+		{Type: MaintenanceOilChange, Months: 12, Kilometers: 15000},
+		{FuelType: string(FuelTypeElectric), Type: MaintenanceOilChange},
+		{Type: MaintenanceInspection, Months: 24},
+	}
+}
+
+// MaintenanceDueQuery is an struct that represents the query of the maintenance due report.
+type MaintenanceDueQuery struct {
+	// Date is the day of the report, today if it is zero.
+	Date time.Time
+	// Days are the days after the date the maintenance due by time is also reported within, 0 for only the overdue one.
+	Days int
+	// Type is the kind of maintenance, every kind if it is empty.
+	Type MaintenanceType
+}
+
+// MaintenanceDue is an struct that represents a maintenance a vehicle needs.
+type MaintenanceDue struct {
+	// Vehicle is the vehicle that needs it.
+	Vehicle Vehicle
+	// Interval is the interval of the vehicle for the kind of maintenance, its type is the one of the maintenance.
+	Interval MaintenanceInterval
+	// Last is the last record of the kind of maintenance, nil if the vehicle never had it.
+	Last *MaintenanceRecord
+	// Odometer is the last distance known of the vehicle (the highest of its records) in km, 0 if it has not any.
+	Odometer int
+	// DueDate is the day it is due by time, zero if it is not due by time or the vehicle never had it.
+	DueDate time.Time
+	// DueOdometer is the distance it is due at in km, 0 if it is not due by distance or the vehicle never had it.
+	DueOdometer int
+	// Overdue is true if it was due on the day of the report, false if it is due within the days after it.
+	Overdue bool
+}
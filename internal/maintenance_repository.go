@@ -0,0 +1,31 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrRepositoryMaintenanceNotFound is returned when a maintenance record is not found.
+	ErrRepositoryMaintenanceNotFound = errors.New("repository: maintenance record not found")
+)
+
+// RepositoryMaintenance is the interface that wraps the basic methods for a maintenance repository,
+// the records of the vehicles and the intervals they are due at.
+type RepositoryMaintenance interface {
+	// FindAll returns the records of all vehicles
+	FindAll() (r []MaintenanceRecord, err error)
+	// FindByVehicle returns the records of a vehicle, by date
+	FindByVehicle(vehicleId int) (r []MaintenanceRecord, err error)
+	// FindById returns the record with the given id
+	FindById(id int) (r MaintenanceRecord, err error)
+	// Insert saves a new record, assigning its id
+	Insert(r MaintenanceRecord) (nr MaintenanceRecord, err error)
+	// Update replaces the attributes of a record
+	Update(id int, a MaintenanceAttributes) (ur MaintenanceRecord, err error)
+	// Delete removes a record
+	Delete(id int) (err error)
+	// DeleteByVehicle removes the records of a vehicle
+	DeleteByVehicle(vehicleId int) (err error)
+	// FindIntervals returns the intervals
+	FindIntervals() (i []MaintenanceInterval, err error)
+	// ReplaceIntervals replaces all the intervals
+	ReplaceIntervals(i []MaintenanceInterval) (err error)
+}
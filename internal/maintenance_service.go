@@ -0,0 +1,30 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrServiceMaintenanceNotFound is returned when a maintenance record of a vehicle is not found.
+	ErrServiceMaintenanceNotFound         = errors.New("service: maintenance record not found")
+	ErrServiceInvalidMaintenanceDate      = errors.New("service: invalid maintenance date")
+	ErrServiceInvalidMaintenanceOdometer  = errors.New("service: invalid maintenance odometer")
+	ErrServiceInvalidMaintenanceType      = errors.New("service: invalid maintenance type")
+	ErrServiceInvalidMaintenanceCost      = errors.New("service: invalid maintenance cost")
+	ErrServiceInvalidMaintenanceIntervals = errors.New("service: invalid maintenance intervals")
+	ErrServiceInvalidMaintenanceDueDays   = errors.New("service: invalid maintenance due days")
+)
+
+// ServiceMaintenance is the interface that wraps the basic methods for a maintenance service.
+type ServiceMaintenance interface {
+	// FindByVehicle returns the records of a vehicle by date, of a kind of maintenance if t is not empty
+	FindByVehicle(vehicleId int, t MaintenanceType) (r []MaintenanceRecord, err error)
+	FindById(vehicleId int, id int) (r MaintenanceRecord, err error)
+	Insert(vehicleId int, a MaintenanceAttributes) (nr MaintenanceRecord, err error)
+	Update(vehicleId int, id int, a MaintenanceAttributes) (ur MaintenanceRecord, err error)
+	Delete(vehicleId int, id int) (err error)
+	// FindIntervals returns the intervals the maintenance is due at
+	FindIntervals() (i []MaintenanceInterval, err error)
+	// ReplaceIntervals replaces all the intervals, returning them normalized
+	ReplaceIntervals(i []MaintenanceInterval) (ni []MaintenanceInterval, err error)
+	// FindAllDue returns the maintenance the vehicles need, the overdue one first
+	FindAllDue(q MaintenanceDueQuery) (d []MaintenanceDue, err error)
+}
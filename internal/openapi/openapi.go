@@ -0,0 +1,39 @@
+// Package openapi contains the OpenAPI document of the api, maintained by hand next to the routes.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Document is the OpenAPI 3 document of the api in json.
+//
+//go:embed openapi.json
+var Document []byte
+
+// ginParam matches the path params of gin routes (e.g. :id).
+var ginParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// Missing returns the routes registered in the router (as "METHOD /path") that are not in the document.
+func Missing(routes gin.RoutesInfo) (missing []string, err error) {
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err = json.Unmarshal(Document, &doc); err != nil {
+		return
+	}
+
+	for _, r := range routes {
+		path := ginParam.ReplaceAllString(r.Path, "{$1}")
+		if _, ok := doc.Paths[path][strings.ToLower(r.Method)]; !ok {
+			missing = append(missing, r.Method+" "+r.Path)
+		}
+	}
+	sort.Strings(missing)
+	return
+}
@@ -0,0 +1,23 @@
+package internal
+
+import "time"
+
+// OwnerAttributes is an struct that represents the attributes of an owner.
+type OwnerAttributes struct {
+	// Name is the full name of the owner.
+	Name string
+	// Email is the email address of the owner.
+	Email string
+	// Phone is the phone number of the owner, empty if it is not known.
+	Phone string
+}
+
+// Owner is an struct that represents a person who owns vehicles.
+type Owner struct {
+	// ID is the unique identifier of the owner.
+	ID int
+	// Attributes is the attributes of the owner.
+	Attributes OwnerAttributes
+	// CreatedAt is when the owner was created.
+	CreatedAt time.Time
+}
@@ -0,0 +1,26 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrRepositoryOwnerNotFound is returned when an owner is not found.
+	ErrRepositoryOwnerNotFound = errors.New("repository: owner not found")
+)
+
+// RepositoryOwner is the interface that wraps the basic methods for an owner repository.
+type RepositoryOwner interface {
+	// FindAll returns all owners
+	FindAll() (o []Owner, err error)
+	// FindById returns the owner with the given id
+	FindById(id int) (o Owner, err error)
+	// Insert saves a new owner, assigning its id
+	Insert(o Owner) (no Owner, err error)
+	// Update replaces the attributes of an owner
+	Update(id int, a OwnerAttributes) (uo Owner, err error)
+	// Delete removes an owner
+	Delete(id int) (err error)
+	// DeleteIf removes an owner if check returns no error, the owner cannot be used meanwhile
+	DeleteIf(id int, check func() (err error)) (err error)
+	// Use calls fn while the owners with the given ids cannot be deleted, it fails if any of them is not found
+	Use(ids []int, fn func() (err error)) (err error)
+}
@@ -0,0 +1,26 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrServiceOwnerNotFound is returned when an owner is not found.
+	ErrServiceOwnerNotFound = errors.New("service: owner not found")
+	// ErrServiceOwnerHasVehicles is returned when an owner who still owns vehicles is deleted.
+	ErrServiceOwnerHasVehicles  = errors.New("service: owner has vehicles")
+	ErrServiceInvalidOwnerName  = errors.New("service: invalid owner name")
+	ErrServiceInvalidOwnerEmail = errors.New("service: invalid owner email")
+	ErrServiceInvalidOwnerPhone = errors.New("service: invalid owner phone")
+)
+
+// ServiceOwner is the interface that wraps the basic methods for an owner service.
+type ServiceOwner interface {
+	// FindAll returns all owners
+	FindAll() (o []Owner, err error)
+	FindById(id int) (o Owner, err error)
+	Insert(a OwnerAttributes) (no Owner, err error)
+	Update(id int, a OwnerAttributes) (uo Owner, err error)
+	// Delete removes an owner, who must not own any vehicle
+	Delete(id int) (err error)
+	// FindVehicles returns the vehicles of an owner
+	FindVehicles(id int) (v []Vehicle, err error)
+}
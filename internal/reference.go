@@ -0,0 +1,103 @@
+package internal
+
+import "sort"
+
+// ReferenceKind is a controlled vocabulary, the values a categorical attribute of the vehicles can have.
+type ReferenceKind string
+
+const (
+	// ReferenceFuelTypes are the fuel types of the vehicles.
+	ReferenceFuelTypes ReferenceKind = "fuel-types"
+	// ReferenceTransmissions are the transmissions of the vehicles.
+	ReferenceTransmissions ReferenceKind = "transmissions"
+	// ReferenceColors are the colors of the vehicles.
+	ReferenceColors ReferenceKind = "colors"
+	// ReferenceBrands are the brands of the vehicles.
+	ReferenceBrands ReferenceKind = "brands"
+	// ReferenceChargingConnectors are the charging connectors of the electric and hybrid vehicles.
+	ReferenceChargingConnectors ReferenceKind = "charging-connectors"
+)
+
+// ReferenceKinds are all the controlled vocabularies.
+var ReferenceKinds = []ReferenceKind{
+	ReferenceFuelTypes,
+	ReferenceTransmissions,
+	ReferenceColors,
+	ReferenceBrands,
+	ReferenceChargingConnectors,
+}
+
+// FuelType is a fuel type of the vehicles.
+type FuelType string
+
+const (
+	// FuelTypeGasoline is gasoline, also written gas or petrol.
+	FuelTypeGasoline FuelType = "gasoline"
+	// FuelTypeDiesel is diesel.
+	FuelTypeDiesel FuelType = "diesel"
+	// FuelTypeBiodiesel is biodiesel.
+	FuelTypeBiodiesel FuelType = "biodiesel"
+	// FuelTypeElectric is a battery electric powertrain, the vehicle has a battery, range and charging connector.
+	FuelTypeElectric FuelType = "electric"
+	// FuelTypeHybrid is a hybrid powertrain, the vehicle has a battery and a charging connector if it is a plug-in.
+	FuelTypeHybrid FuelType = "hybrid"
+)
+
+// Transmission is a transmission of the vehicles.
+type Transmission string
+
+const (
+	// TransmissionAutomatic is an automatic transmission.
+	TransmissionAutomatic Transmission = "automatic"
+	// TransmissionSemiAutomatic is a semi-automatic transmission.
+	TransmissionSemiAutomatic Transmission = "semi-automatic"
+	// TransmissionManual is a manual transmission.
+	TransmissionManual Transmission = "manual"
+)
+
+// DefaultReferences returns the values each vocabulary has before any is added, sorted.
+// Brands are the ones with a canonical name (see NormalizeBrand).
+func DefaultReferences() map[ReferenceKind][]string {
+	brands := make([]string, 0, len(vehicleBrands))
+	seen := make(map[string]bool)
+	for _, b := range vehicleBrands {
+		if !seen[b] {
+			seen[b] = true
+			brands = append(brands, b)
+		}
+	}
+	sort.Strings(brands)
+
+	return map[ReferenceKind][]string{
+		ReferenceFuelTypes: {
+			string(FuelTypeBiodiesel), string(FuelTypeDiesel), string(FuelTypeElectric), string(FuelTypeGasoline), string(FuelTypeHybrid),
+		},
+		ReferenceTransmissions: {
+			string(TransmissionAutomatic), string(TransmissionManual), string(TransmissionSemiAutomatic),
+		},
+		ReferenceColors: {
+			"Aquamarine", "Beige", "Black", "Blue", "Brown", "Crimson", "Fuchsia", "Gold", "Goldenrod", "Gray", "Green",
+			"Indigo", "Khaki", "Maroon", "Mauve", "Orange", "Pink", "Puce", "Purple", "Red", "Silver", "Teal",
+			"Turquoise", "Violet", "White", "Yellow",
+		},
+		ReferenceBrands:             brands,
+		ReferenceChargingConnectors: {"CCS1", "CCS2", "CHAdeMO", "GB/T", "NACS", "Type 1", "Type 2"},
+	}
+}
+
+// NormalizeReference returns the normalized value of a vocabulary (e.g. gas is gasoline), the value if the vocabulary is unknown.
+func NormalizeReference(k ReferenceKind, v string) string {
+	switch k {
+	case ReferenceFuelTypes:
+		return NormalizeFuelType(v)
+	case ReferenceTransmissions:
+		return NormalizeTransmission(v)
+	case ReferenceColors:
+		return NormalizeColor(v)
+	case ReferenceBrands:
+		return NormalizeBrand(v)
+	case ReferenceChargingConnectors:
+		return NormalizeChargingConnector(v)
+	}
+	return v
+}
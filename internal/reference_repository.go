@@ -0,0 +1,20 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrRepositoryReferenceNotFound is returned when a value is not in a vocabulary.
+	ErrRepositoryReferenceNotFound = errors.New("repository: reference value not found")
+	// ErrRepositoryReferenceAlreadyExists is returned when a value is already in a vocabulary.
+	ErrRepositoryReferenceAlreadyExists = errors.New("repository: reference value already exists")
+)
+
+// RepositoryReference is the interface that wraps the basic methods for a repository of controlled vocabularies.
+type RepositoryReference interface {
+	// FindAll returns the values of a vocabulary, sorted
+	FindAll(k ReferenceKind) (v []string, err error)
+	// Find returns the value of a vocabulary equal to the given one, ignoring case
+	Find(k ReferenceKind, v string) (rv string, err error)
+	// Insert adds a value to a vocabulary
+	Insert(k ReferenceKind, v string) (err error)
+}
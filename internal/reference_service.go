@@ -0,0 +1,21 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrServiceReferenceKindNotFound is returned when a vocabulary is unknown.
+	ErrServiceReferenceKindNotFound  = errors.New("service: reference kind not found")
+	ErrServiceReferenceNotFound      = errors.New("service: reference value not found")
+	ErrServiceReferenceAlreadyExists = errors.New("service: reference value already exists")
+	ErrServiceInvalidReferenceValue  = errors.New("service: invalid reference value")
+)
+
+// ServiceReference is the interface that wraps the basic methods for a service of controlled vocabularies.
+type ServiceReference interface {
+	// FindAll returns the values of a vocabulary, sorted
+	FindAll(k ReferenceKind) (v []string, err error)
+	// Insert adds a value to a vocabulary, normalized as the attribute of the vehicles
+	Insert(k ReferenceKind, v string) (nv string, err error)
+	// Canonical returns the value of a vocabulary a value is written as (e.g. Gas is gasoline)
+	Canonical(k ReferenceKind, v string) (c string, err error)
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"app/internal"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NewFleetMap returns a new instance of a fleet repository in a map.
+func NewFleetMap() *FleetMap {
+	return &FleetMap{db: make(map[int]internal.Fleet)}
+}
+
+// FleetMap is an struct that represents a fleet repository in a map.
+type FleetMap struct {
+	// mu guards the fields below.
+	mu sync.RWMutex
+	// db is the database of fleets by id.
+	db map[int]internal.Fleet
+	// lastId is the last id of the database.
+	lastId int
+}
+
+// FindAll returns all fleets, ordered by id.
+func (r *FleetMap) FindAll() (f []internal.Fleet, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f = make([]internal.Fleet, 0, len(r.db))
+	for _, fleet := range r.db {
+		f = append(f, copyFleet(fleet))
+	}
+	sort.Slice(f, func(i, j int) bool { return f[i].ID < f[j].ID })
+	return
+}
+
+// FindById returns the fleet with the given id.
+func (r *FleetMap) FindById(id int) (f internal.Fleet, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryFleetNotFound
+		return
+	}
+	f = copyFleet(f)
+	return
+}
+
+// Insert saves a new fleet, assigning its id.
+func (r *FleetMap) Insert(f internal.Fleet) (nf internal.Fleet, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nameTaken(f.Attributes.Name, 0) {
+		err = internal.ErrRepositoryFleetNameAlreadyExists
+		return
+	}
+
+	r.lastId++
+	f.ID = r.lastId
+	f = copyFleet(f)
+	r.db[f.ID] = f
+	nf = copyFleet(f)
+	return
+}
+
+// Update replaces the attributes of a fleet, keeping its vehicles.
+func (r *FleetMap) Update(id int, a internal.FleetAttributes) (uf internal.Fleet, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uf, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryFleetNotFound
+		return
+	}
+	if r.nameTaken(a.Name, id) {
+		err = internal.ErrRepositoryFleetNameAlreadyExists
+		return
+	}
+	uf.Attributes = a
+	r.db[id] = uf
+	uf = copyFleet(uf)
+	return
+}
+
+// Delete removes a fleet.
+func (r *FleetMap) Delete(id int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[id]; !ok {
+		err = internal.ErrRepositoryFleetNotFound
+		return
+	}
+	delete(r.db, id)
+	return
+}
+
+// AddVehicles adds vehicles to a fleet, keeping its vehicles in ascending order without duplicates.
+func (r *FleetMap) AddVehicles(id int, vehicleIds []int) (uf internal.Fleet, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uf, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryFleetNotFound
+		return
+	}
+	ids := append(slices.Clone(uf.VehicleIDs), vehicleIds...)
+	slices.Sort(ids)
+	uf.VehicleIDs = slices.Compact(ids)
+	r.db[id] = uf
+	uf = copyFleet(uf)
+	return
+}
+
+// RemoveVehicle removes a vehicle from a fleet.
+func (r *FleetMap) RemoveVehicle(id int, vehicleId int) (uf internal.Fleet, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uf, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryFleetNotFound
+		return
+	}
+	i, ok := slices.BinarySearch(uf.VehicleIDs, vehicleId)
+	if !ok {
+		err = internal.ErrRepositoryFleetVehicleNotFound
+		return
+	}
+	uf.VehicleIDs = slices.Delete(slices.Clone(uf.VehicleIDs), i, i+1)
+	r.db[id] = uf
+	uf = copyFleet(uf)
+	return
+}
+
+// RemoveVehicleFromAll removes a vehicle from every fleet it belongs to.
+func (r *FleetMap) RemoveVehicleFromAll(vehicleId int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, f := range r.db {
+		if i, ok := slices.BinarySearch(f.VehicleIDs, vehicleId); ok {
+			f.VehicleIDs = slices.Delete(slices.Clone(f.VehicleIDs), i, i+1)
+			r.db[id] = f
+		}
+	}
+	return
+}
+
+// nameTaken returns whether a fleet other than the one with the given id has the name, regardless of case.
+func (r *FleetMap) nameTaken(name string, id int) bool {
+	for _, f := range r.db {
+		if f.ID != id && strings.EqualFold(f.Attributes.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyFleet returns a copy of a fleet that does not share its vehicles with it.
+func copyFleet(f internal.Fleet) internal.Fleet {
+	f.VehicleIDs = slices.Clone(f.VehicleIDs)
+	if f.VehicleIDs == nil {
+		f.VehicleIDs = []int{}
+	}
+	return f
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"app/internal"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// NewMaintenanceMap returns a new instance of a maintenance repository in a map, with the given intervals.
+func NewMaintenanceMap(intervals []internal.MaintenanceInterval) *MaintenanceMap {
+	return &MaintenanceMap{db: make(map[int]internal.MaintenanceRecord), intervals: slices.Clone(intervals)}
+}
+
+// MaintenanceMap is an struct that represents a maintenance repository in a map.
+type MaintenanceMap struct {
+	// mu guards the fields below.
+	mu sync.RWMutex
+	// db is the database of records by id.
+	db map[int]internal.MaintenanceRecord
+	// lastId is the last id of the database.
+	lastId int
+	// intervals are the intervals the maintenance is due at.
+	intervals []internal.MaintenanceInterval
+}
+
+// FindAll returns the records of all vehicles, by vehicle and date.
+func (r *MaintenanceMap) FindAll() (m []internal.MaintenanceRecord, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m = make([]internal.MaintenanceRecord, 0, len(r.db))
+	for _, rc := range r.db {
+		m = append(m, rc)
+	}
+	sortMaintenance(m)
+	return
+}
+
+// FindByVehicle returns the records of a vehicle, by date.
+func (r *MaintenanceMap) FindByVehicle(vehicleId int) (m []internal.MaintenanceRecord, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m = make([]internal.MaintenanceRecord, 0)
+	for _, rc := range r.db {
+		if rc.VehicleID == vehicleId {
+			m = append(m, rc)
+		}
+	}
+	sortMaintenance(m)
+	return
+}
+
+// FindById returns the record with the given id.
+func (r *MaintenanceMap) FindById(id int) (m internal.MaintenanceRecord, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryMaintenanceNotFound
+	}
+	return
+}
+
+// Insert saves a new record, assigning its id.
+func (r *MaintenanceMap) Insert(m internal.MaintenanceRecord) (nm internal.MaintenanceRecord, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastId++
+	m.ID = r.lastId
+	r.db[m.ID] = m
+	nm = m
+	return
+}
+
+// Update replaces the attributes of a record.
+func (r *MaintenanceMap) Update(id int, a internal.MaintenanceAttributes) (um internal.MaintenanceRecord, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	um, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryMaintenanceNotFound
+		return
+	}
+	um.Attributes = a
+	r.db[id] = um
+	return
+}
+
+// Delete removes a record.
+func (r *MaintenanceMap) Delete(id int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[id]; !ok {
+		err = internal.ErrRepositoryMaintenanceNotFound
+		return
+	}
+	delete(r.db, id)
+	return
+}
+
+// DeleteByVehicle removes the records of a vehicle.
+func (r *MaintenanceMap) DeleteByVehicle(vehicleId int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, rc := range r.db {
+		if rc.VehicleID == vehicleId {
+			delete(r.db, id)
+		}
+	}
+	return
+}
+
+// FindIntervals returns the intervals.
+func (r *MaintenanceMap) FindIntervals() (i []internal.MaintenanceInterval, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i = slices.Clone(r.intervals)
+	if i == nil {
+		i = []internal.MaintenanceInterval{}
+	}
+	return
+}
+
+// ReplaceIntervals replaces all the intervals.
+func (r *MaintenanceMap) ReplaceIntervals(i []internal.MaintenanceInterval) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.intervals = slices.Clone(i)
+	return
+}
+
+// sortMaintenance sorts records by vehicle, date and id.
+func sortMaintenance(m []internal.MaintenanceRecord) {
+	sort.Slice(m, func(i, j int) bool {
+		if m[i].VehicleID != m[j].VehicleID {
+			return m[i].VehicleID < m[j].VehicleID
+		}
+		if !m[i].Attributes.Date.Equal(m[j].Attributes.Date) {
+			return m[i].Attributes.Date.Before(m[j].Attributes.Date)
+		}
+		return m[i].ID < m[j].ID
+	})
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"app/internal"
+	"sort"
+	"sync"
+)
+
+// NewOwnerMap returns a new instance of an owner repository in a map.
+func NewOwnerMap() *OwnerMap {
+	return &OwnerMap{db: make(map[int]internal.Owner)}
+}
+
+// OwnerMap is an struct that represents an owner repository in a map.
+type OwnerMap struct {
+	// mu guards the fields below.
+	mu sync.RWMutex
+	// db is the database of owners by id.
+	db map[int]internal.Owner
+	// lastId is the last id of the database.
+	lastId int
+}
+
+// FindAll returns all owners, ordered by id.
+func (r *OwnerMap) FindAll() (o []internal.Owner, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	o = make([]internal.Owner, 0, len(r.db))
+	for _, owner := range r.db {
+		o = append(o, owner)
+	}
+	sort.Slice(o, func(i, j int) bool { return o[i].ID < o[j].ID })
+	return
+}
+
+// FindById returns the owner with the given id.
+func (r *OwnerMap) FindById(id int) (o internal.Owner, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	o, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryOwnerNotFound
+	}
+	return
+}
+
+// Insert saves a new owner, assigning its id.
+func (r *OwnerMap) Insert(o internal.Owner) (no internal.Owner, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastId++
+	o.ID = r.lastId
+	r.db[o.ID] = o
+	no = o
+	return
+}
+
+// Update replaces the attributes of an owner.
+func (r *OwnerMap) Update(id int, a internal.OwnerAttributes) (uo internal.Owner, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uo, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryOwnerNotFound
+		return
+	}
+	uo.Attributes = a
+	r.db[id] = uo
+	return
+}
+
+// Delete removes an owner.
+func (r *OwnerMap) Delete(id int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[id]; !ok {
+		err = internal.ErrRepositoryOwnerNotFound
+		return
+	}
+	delete(r.db, id)
+	return
+}
+
+// DeleteIf removes an owner if check returns no error.
+// The lock is held while check runs, so the owner cannot be used meanwhile.
+func (r *OwnerMap) DeleteIf(id int, check func() (err error)) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[id]; !ok {
+		err = internal.ErrRepositoryOwnerNotFound
+		return
+	}
+	if err = check(); err != nil {
+		return
+	}
+	delete(r.db, id)
+	return
+}
+
+// Use calls fn while the owners with the given ids cannot be deleted.
+// fn must not call the repository, the read lock is held while it runs.
+func (r *OwnerMap) Use(ids []int, fn func() (err error)) (err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, id := range ids {
+		if _, ok := r.db[id]; !ok {
+			err = internal.ErrRepositoryOwnerNotFound
+			return
+		}
+	}
+	return fn()
+}
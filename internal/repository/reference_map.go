@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"app/internal"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NewReferenceMap returns a new instance of a repository of controlled vocabularies in a map, with the given values.
+func NewReferenceMap(values map[internal.ReferenceKind][]string) *ReferenceMap {
+	db := make(map[internal.ReferenceKind]map[string]string)
+	for _, k := range internal.ReferenceKinds {
+		db[k] = make(map[string]string)
+	}
+	for k, vs := range values {
+		if db[k] == nil {
+			db[k] = make(map[string]string)
+		}
+		for _, v := range vs {
+			db[k][strings.ToLower(v)] = v
+		}
+	}
+	return &ReferenceMap{db: db}
+}
+
+// ReferenceMap is an struct that represents a repository of controlled vocabularies in a map.
+type ReferenceMap struct {
+	// mu guards the database, values are added while vehicles are validated.
+	mu sync.RWMutex
+	// db are the values of each vocabulary, by their lowercase value.
+	db map[internal.ReferenceKind]map[string]string
+}
+
+// FindAll returns the values of a vocabulary, sorted.
+func (r *ReferenceMap) FindAll(k internal.ReferenceKind) (v []string, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	values, ok := r.db[k]
+	if !ok {
+		err = internal.ErrRepositoryReferenceNotFound
+		return
+	}
+	v = make([]string, 0, len(values))
+	for _, value := range values {
+		v = append(v, value)
+	}
+	sort.Strings(v)
+	return
+}
+
+// Find returns the value of a vocabulary equal to the given one, ignoring case.
+func (r *ReferenceMap) Find(k internal.ReferenceKind, v string) (rv string, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rv, ok := r.db[k][strings.ToLower(v)]
+	if !ok {
+		err = internal.ErrRepositoryReferenceNotFound
+	}
+	return
+}
+
+// Insert adds a value to a vocabulary.
+func (r *ReferenceMap) Insert(k internal.ReferenceKind, v string) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	values, ok := r.db[k]
+	if !ok {
+		err = internal.ErrRepositoryReferenceNotFound
+		return
+	}
+	if _, ok := values[strings.ToLower(v)]; ok {
+		err = internal.ErrRepositoryReferenceAlreadyExists
+		return
+	}
+	values[strings.ToLower(v)] = v
+	return
+}
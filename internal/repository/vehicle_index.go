@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"app/internal"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// vehicleIndexFields are the searchable attributes of a vehicle, with the weight of their matches.
+var vehicleIndexFields = []struct {
+	value  func(a internal.VehicleAttributes) string
+	weight float64
+}{
+	{func(a internal.VehicleAttributes) string { return a.Brand }, 1.2},
+	{func(a internal.VehicleAttributes) string { return a.Model }, 1.2},
+	{func(a internal.VehicleAttributes) string { return a.Color }, 1},
+	{func(a internal.VehicleAttributes) string { return a.Registration }, 1.5},
+}
+
+// tokenize returns the lowercase words of a text, split by any character that is not a letter or a digit.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// newVehicleIndex returns an inverted index of the vehicles.
+func newVehicleIndex(db []internal.Vehicle) *vehicleIndex {
+	ix := &vehicleIndex{
+		postings: make(map[string]map[int]float64),
+		terms:    make(map[int][]string),
+	}
+	for _, v := range db {
+		ix.add(v)
+	}
+	return ix
+}
+
+// vehicleIndex is an struct that represents an inverted index of the searchable attributes of the vehicles.
+// It is not safe for concurrent use, it is guarded by the lock of the repository.
+type vehicleIndex struct {
+	// postings are the ids of the vehicles with each term, with the weight of the field it was found in.
+	postings map[string]map[int]float64
+	// terms are the terms of each vehicle, by id, so they can be removed.
+	terms map[int][]string
+}
+
+// add indexes the searchable attributes of a vehicle.
+// Fields of several words are also indexed as a single term (e.g. landcruiser, ab123cd).
+func (ix *vehicleIndex) add(v internal.Vehicle) {
+	ix.remove(v.ID)
+	for _, f := range vehicleIndexFields {
+		tokens := tokenize(f.value(v.Attributes))
+		if len(tokens) > 1 {
+			tokens = append(tokens, strings.Join(tokens, ""))
+		}
+		for _, t := range tokens {
+			ids, ok := ix.postings[t]
+			if !ok {
+				ids = make(map[int]float64)
+				ix.postings[t] = ids
+			}
+			if f.weight > ids[v.ID] {
+				ids[v.ID] = f.weight
+			}
+			ix.terms[v.ID] = append(ix.terms[v.ID], t)
+		}
+	}
+}
+
+// remove removes a vehicle from the index.
+func (ix *vehicleIndex) remove(id int) {
+	for _, t := range ix.terms[id] {
+		delete(ix.postings[t], id)
+		if len(ix.postings[t]) == 0 {
+			delete(ix.postings, t)
+		}
+	}
+	delete(ix.terms, id)
+}
+
+// search returns the relevance of the vehicles matching any word of the query, by id.
+// Each word matches the terms equal to it, starting with it or within a few typos of either (e.g. chevy matches chevrolet).
+// The relevance adds the best match of each word, weighted by the field and the rarity of the word,
+// and is scaled by the fraction of the words matched.
+func (ix *vehicleIndex) search(q string) map[int]float64 {
+	words := tokenize(q)
+	if len(words) == 0 {
+		return nil
+	}
+
+	n := float64(len(ix.terms))
+	scores := make(map[int]float64)
+	matched := make(map[int]int)
+	for _, w := range words {
+		// best match of the word for each vehicle
+		best := make(map[int]float64)
+		for t, ids := range ix.postings {
+			sim := similarity(w, t)
+			if sim == 0 {
+				continue
+			}
+			for id, weight := range ids {
+				if s := sim * weight; s > best[id] {
+					best[id] = s
+				}
+			}
+		}
+		// - the rarity is the one of the word and not of each term, so typos of rare terms do not outrank equal terms
+		idf := math.Log(1 + n/float64(len(best)))
+		for id, s := range best {
+			scores[id] += s * idf
+			matched[id]++
+		}
+	}
+	for id := range scores {
+		scores[id] *= float64(matched[id]) / float64(len(words))
+	}
+	return scores
+}
+
+// maxTypos returns the number of typos tolerated in a word, depending on its length.
+func maxTypos(w []rune) int {
+	switch {
+	case len(w) <= 2:
+		return 0
+	case len(w) <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// similarity returns how much a term matches a word, from 0 (not at all) to 1 (equal).
+// Prefixes and terms with typos match less than equal terms.
+func similarity(word, term string) float64 {
+	if word == term {
+		return 1
+	}
+	w, t := []rune(word), []rune(term)
+	typos := maxTypos(w)
+
+	score := 0.0
+	if d := distance(w, t, typos); d <= typos {
+		score = 1 - 0.3*float64(d)
+	}
+	if len(t) > len(w) {
+		if d := distance(w, t[:len(w)], typos); d <= typos {
+			score = math.Max(score, 0.8-0.3*float64(d))
+		}
+	}
+	return score
+}
+
+// distance returns the optimal string alignment distance between two words (edits, including swaps of adjacent characters),
+// or limit+1 if it is greater than limit.
+func distance(a, b []rune, limit int) int {
+	if abs(len(a)-len(b)) > limit {
+		return limit + 1
+	}
+
+	// rows of the matrix of distances between the prefixes
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return min(prev[len(b)], limit+1)
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"app/internal"
+	"math"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		s      string
+		tokens []string
+	}{
+		{"", []string{}},
+		{"Ford", []string{"ford"}},
+		{"Land-Rover  Defender_90", []string{"land", "rover", "defender", "90"}},
+		{"Citroën C4", []string{"citroën", "c4"}},
+		{"AB 123-CD", []string{"ab", "123", "cd"}},
+		{" !? ", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if tokens := tokenize(tt.s); !slices.Equal(tokens, tt.tokens) {
+				t.Errorf("tokens = %q, want %q", tokens, tt.tokens)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		limit int
+		want  int
+	}{
+		{"ford", "ford", 2, 0},
+		{"frod", "ford", 2, 1},
+		{"fort", "ford", 1, 1},
+		{"chevrloet", "chevrolet", 2, 1},
+		{"chevy", "chevrolet", 2, 3},
+		{"abc", "xyz", 1, 2},
+		{"", "ab", 2, 2},
+		{"ñandú", "nandu", 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if d := distance([]rune(tt.a), []rune(tt.b), tt.limit); d != tt.want {
+				t.Errorf("distance = %d, want %d", d, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		word, term string
+		want       float64
+	}{
+		{"ford", "ford", 1},
+		{"frod", "ford", 0.7},
+		{"mustnag", "mustang", 0.7},
+		{"mustnga", "mustang", 0.4},
+		{"chev", "chevrolet", 0.8},
+		{"chevy", "chevrolet", 0.5},
+		{"vw", "vwx", 0.8},
+		{"vw", "vx", 0},
+		{"ford", "nissan", 0},
+		{"focus", "foc", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word+" "+tt.term, func(t *testing.T) {
+			if got := similarity(tt.word, tt.term); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("similarity = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// searchIds returns the ids of the vehicles matching the query, by descending relevance (ties by id).
+func searchIds(ix *vehicleIndex, q string) []int {
+	scores := ix.search(q)
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+func TestVehicleIndex_Search(t *testing.T) {
+	ix := newVehicleIndex([]internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Focus", Color: "Blue", Registration: "AAA111"}},
+		{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Mustang", Color: "Red", Registration: "BBB222"}},
+		{ID: 3, Attributes: internal.VehicleAttributes{Brand: "Chevrolet", Model: "Camaro", Color: "Red", Registration: "CCC333"}},
+		{ID: 4, Attributes: internal.VehicleAttributes{Brand: "Land Rover", Model: "Defender", Color: "Green", Registration: "AB 123 CD"}},
+	})
+
+	tests := []struct {
+		name string
+		q    string
+		ids  []int
+	}{
+		{"a word", "ford", []int{1, 2}},
+		{"case insensitive", "FORD", []int{1, 2}},
+		{"all the words first", "ford mustang", []int{2, 1}},
+		{"a rare word first", "red camaro", []int{3, 2}},
+		{"a typo", "mustnag", []int{2}},
+		{"a prefix", "chev", []int{3}},
+		{"a prefix with a typo", "chevy", []int{3}},
+		{"a field of several words joined", "landrover", []int{4}},
+		{"a registration", "aaa111", []int{1}},
+		{"a registration with spaces joined", "ab123cd", []int{4}},
+		{"a registration with its own spaces", "AB 123 CD", []int{4}},
+		{"a word not indexed", "tesla", []int{}},
+		{"without words", " - ", []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ids := searchIds(ix, tt.q); !slices.Equal(ids, tt.ids) {
+				t.Errorf("ids = %v, want %v", ids, tt.ids)
+			}
+		})
+	}
+
+	t.Run("an equal term outranks a typo of it", func(t *testing.T) {
+		ix := newVehicleIndex([]internal.Vehicle{
+			{ID: 1, Attributes: internal.VehicleAttributes{Model: "Fort"}},
+			{ID: 2, Attributes: internal.VehicleAttributes{Model: "Ford"}},
+		})
+		if ids := searchIds(ix, "ford"); !slices.Equal(ids, []int{2, 1}) {
+			t.Errorf("ids = %v, want [2 1]", ids)
+		}
+	})
+	t.Run("a registration outranks a color", func(t *testing.T) {
+		ix := newVehicleIndex([]internal.Vehicle{
+			{ID: 1, Attributes: internal.VehicleAttributes{Color: "Red"}},
+			{ID: 2, Attributes: internal.VehicleAttributes{Registration: "RED"}},
+		})
+		if ids := searchIds(ix, "red"); !slices.Equal(ids, []int{2, 1}) {
+			t.Errorf("ids = %v, want [2 1]", ids)
+		}
+	})
+}
+
+func TestVehicleIndex_Update(t *testing.T) {
+	ix := newVehicleIndex([]internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Focus"}},
+		{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Mustang"}},
+	})
+
+	// the terms of a vehicle added again are replaced
+	ix.add(internal.Vehicle{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Nissan", Model: "Leaf"}})
+	if ids := searchIds(ix, "mustang"); len(ids) != 0 {
+		t.Errorf("ids of the old model = %v, want none", ids)
+	}
+	if ids := searchIds(ix, "leaf"); !slices.Equal(ids, []int{2}) {
+		t.Errorf("ids of the new model = %v, want [2]", ids)
+	}
+
+	// a removed vehicle is not found, and its terms are removed with the last vehicle with them
+	ix.remove(1)
+	if ids := searchIds(ix, "ford"); len(ids) != 0 {
+		t.Errorf("ids of a removed vehicle = %v, want none", ids)
+	}
+	if _, ok := ix.postings["focus"]; ok {
+		t.Errorf("postings = %v, want focus removed", ix.postings)
+	}
+	if len(ix.terms) != 1 {
+		t.Errorf("terms = %v, want the ones of a vehicle", ix.terms)
+	}
+}
@@ -0,0 +1,76 @@
+package repository
+
+import "app/internal"
+
+// NewVehicleScope returns a view of a vehicle repository with only the vehicles with the given ids.
+func NewVehicleScope(rp internal.RepositoryVehicle, ids []int) *VehicleScope {
+	s := &VehicleScope{RepositoryVehicle: rp, ids: make(map[int]struct{}, len(ids))}
+	for _, id := range ids {
+		s.ids[id] = struct{}{}
+	}
+	return s
+}
+
+// VehicleScope is an struct that represents a view of a vehicle repository with some of its vehicles.
+// Only the reads are scoped, the writes go to the whole repository.
+type VehicleScope struct {
+	internal.RepositoryVehicle
+	// ids are the ids of the vehicles of the view.
+	ids map[int]struct{}
+}
+
+// FindAll returns the vehicles of the view.
+func (r *VehicleScope) FindAll() (v []internal.Vehicle, err error) {
+	err = r.ForEach(func(vh internal.Vehicle) (err error) {
+		v = append(v, vh)
+		return
+	})
+	return
+}
+
+// FindById returns the vehicle with the given id, if it is in the view.
+func (r *VehicleScope) FindById(id int) (v internal.Vehicle, err error) {
+	if _, ok := r.ids[id]; !ok {
+		err = internal.ErrRepositoryVehicleNotFound
+		return
+	}
+	return r.RepositoryVehicle.FindById(id)
+}
+
+// ForEach calls fn with each vehicle of the view, in order, until fn returns an error.
+func (r *VehicleScope) ForEach(fn func(v internal.Vehicle) (err error)) (err error) {
+	var n int
+	err = r.RepositoryVehicle.ForEach(func(v internal.Vehicle) (err error) {
+		if _, ok := r.ids[v.ID]; !ok {
+			return
+		}
+		n++
+		return fn(v)
+	})
+	if err == nil && n == 0 {
+		err = internal.ErrRepositoryVehiclesNotFound
+	}
+	return
+}
+
+// Search returns the vehicles of the view matching the words of a query, from the most to the least relevant.
+func (r *VehicleScope) Search(q string, limit int) (s []internal.VehicleSearchResult, err error) {
+	// - the limit is applied after the vehicles out of the view are left out
+	all, err := r.RepositoryVehicle.Search(q, 0)
+	if err != nil {
+		return
+	}
+	for _, sr := range all {
+		if _, ok := r.ids[sr.Vehicle.ID]; ok {
+			s = append(s, sr)
+		}
+	}
+	if len(s) == 0 {
+		err = internal.ErrRepositoryVehiclesNotFound
+		return
+	}
+	if limit > 0 && len(s) > limit {
+		s = s[:limit]
+	}
+	return
+}
@@ -1,25 +1,39 @@
 package repository
 
-import "app/internal"
+import (
+	"app/internal"
+	"slices"
+	"sort"
+	"sync"
+)
 
 // NewVehicleSlice returns a new instance of a vehicle repository in an slice.
 func NewVehicleSlice(db []internal.Vehicle, lastId int) *VehicleSlice {
 	return &VehicleSlice{
 		db:     db,
 		lastId: lastId,
+		ix:     newVehicleIndex(db),
 	}
 }
 
 // VehicleSlice is an struct that represents a vehicle repository in an slice.
 type VehicleSlice struct {
-	// db is the database of vehicles.
+	// mu guards the database, vehicles can be read while they are streamed.
+	mu sync.RWMutex
+	// db is the database of vehicles. Its vehicles are never changed in place, changes and deletes replace the
+	// slice with a copy so the ones being streamed stay as they were. Inserts append past the end of it.
 	db []internal.Vehicle
 	// lastId is the last id of the database.
 	lastId int
+	// ix is the inverted index of the vehicles, updated with every change.
+	ix *vehicleIndex
 }
 
 // FindAll returns all vehicles
 func (r *VehicleSlice) FindAll() (v []internal.Vehicle, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	// check if the database is empty
 	if len(r.db) == 0 {
 		err = internal.ErrRepositoryVehiclesNotFound
@@ -32,7 +46,25 @@ func (r *VehicleSlice) FindAll() (v []internal.Vehicle, err error) {
 	return
 }
 
+// FindById returns the vehicle with the given id.
+func (r *VehicleSlice) FindById(id int) (v internal.Vehicle, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, vh := range r.db {
+		if vh.ID == id {
+			v = vh
+			return
+		}
+	}
+	err = internal.ErrRepositoryVehicleNotFound
+	return
+}
+
 func (r *VehicleSlice) Insert(v internal.Vehicle) (nv internal.Vehicle, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.lastId++
 	if v.ID == r.lastId {
 		r.lastId--
@@ -41,11 +73,15 @@ func (r *VehicleSlice) Insert(v internal.Vehicle) (nv internal.Vehicle, err erro
 	}
 	v.ID = r.lastId
 	r.db = append(r.db, v)
+	r.ix.add(v)
 	nv = v
 	return nv, nil
 }
 
 func (r *VehicleSlice) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	nvs = make([]internal.Vehicle, 0)
 	for _, vehicle := range v {
 		r.lastId++
@@ -56,16 +92,22 @@ func (r *VehicleSlice) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle,
 		}
 		vehicle.ID = r.lastId
 		r.db = append(r.db, vehicle)
+		r.ix.add(vehicle)
 		nvs = append(nvs, vehicle)
 	}
 	return nvs, nil
 }
 
 func (r *VehicleSlice) UpdateMaxSpeedById(id int, ms int) (uv internal.Vehicle, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	for i := range r.db {
 		if r.db[i].ID == id {
-			r.db[i].Attributes.MaxSpeed = ms
 			uv = r.db[i]
+			uv.Attributes.MaxSpeed = ms
+			r.replace(i, uv)
+			r.ix.add(uv)
 			return
 		}
 	}
@@ -73,6 +115,9 @@ func (r *VehicleSlice) UpdateMaxSpeedById(id int, ms int) (uv internal.Vehicle,
 }
 
 func (r *VehicleSlice) Delete(id int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	index := -1
 	for i := range r.db {
 		if r.db[i].ID == id {
@@ -84,18 +129,105 @@ func (r *VehicleSlice) Delete(id int) (err error) {
 		return internal.ErrRepositoryVehicleNotFound
 	}
 
-	r.db = append(r.db[:index], r.db[index+1:]...)
+	db := make([]internal.Vehicle, 0, len(r.db)-1)
+	db = append(db, r.db[:index]...)
+	r.db = append(db, r.db[index+1:]...)
+	r.ix.remove(id)
 
 	return nil
 }
 
-func (r *VehicleSlice) UpdateFuelTypeById(id int, ft string) (uv internal.Vehicle, err error) {
+// UpdateFuelTypeById changes the fuel type and energy attributes of a vehicle with update, under the lock.
+// Only those attributes of the copy update changes are saved.
+func (r *VehicleSlice) UpdateFuelTypeById(id int, update func(a *internal.VehicleAttributes) (err error)) (uv internal.Vehicle, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.db {
+		if r.db[i].ID == id {
+			a := r.db[i].Attributes
+			if err = update(&a); err != nil {
+				return
+			}
+			uv = r.db[i]
+			uv.Attributes.FuelType = a.FuelType
+			uv.Attributes.BatteryCapacity = a.BatteryCapacity
+			uv.Attributes.Range = a.Range
+			uv.Attributes.ChargingConnector = a.ChargingConnector
+			r.replace(i, uv)
+			r.ix.add(uv)
+			return
+		}
+	}
+	return internal.Vehicle{}, internal.ErrRepositoryVehicleNotFound
+}
+
+func (r *VehicleSlice) UpdateOwnerById(id int, ownerId int) (uv internal.Vehicle, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	for i := range r.db {
 		if r.db[i].ID == id {
-			r.db[i].Attributes.FuelType = ft
 			uv = r.db[i]
+			uv.OwnerID = ownerId
+			r.replace(i, uv)
 			return
 		}
 	}
 	return internal.Vehicle{}, internal.ErrRepositoryVehicleNotFound
 }
+
+// replace saves the vehicle at the index of the database in a copy of it.
+func (r *VehicleSlice) replace(i int, v internal.Vehicle) {
+	db := slices.Clone(r.db)
+	db[i] = v
+	r.db = db
+}
+
+// ForEach calls fn with each vehicle, in order, until fn returns an error.
+// The vehicles are the ones of the database when it is called, changes made meanwhile are not visited
+// and the lock is not held while fn runs.
+func (r *VehicleSlice) ForEach(fn func(v internal.Vehicle) (err error)) (err error) {
+	r.mu.RLock()
+	db := r.db
+	r.mu.RUnlock()
+	if len(db) == 0 {
+		err = internal.ErrRepositoryVehiclesNotFound
+		return
+	}
+
+	for _, v := range db {
+		if err = fn(v); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Search returns the vehicles matching any word of the query in the brand, model, color or registration,
+// with typos, from the most to the least relevant, at most limit of them if limit is greater than 0.
+func (r *VehicleSlice) Search(q string, limit int) (s []internal.VehicleSearchResult, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	scores := r.ix.search(q)
+	if len(scores) == 0 {
+		err = internal.ErrRepositoryVehiclesNotFound
+		return
+	}
+
+	s = make([]internal.VehicleSearchResult, 0, len(scores))
+	for _, v := range r.db {
+		if score, ok := scores[v.ID]; ok {
+			s = append(s, internal.VehicleSearchResult{Vehicle: v, Score: score})
+		}
+	}
+	// - ties keep the order of the database
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].Score > s[j].Score
+	})
+	if limit > 0 && len(s) > limit {
+		s = s[:limit]
+	}
+	return
+}
\ No newline at end of file
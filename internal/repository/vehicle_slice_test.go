@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"app/internal"
+	"slices"
+	"testing"
+)
+
+func TestVehicleSlice_ForEachDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		deletes []int
+	}{
+		{"the current vehicle", []int{1}},
+		{"a visited vehicle", []int{0}},
+		{"the next vehicle", []int{2}},
+		{"every vehicle", []int{0, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := make([]internal.Vehicle, 5)
+			for i := range db {
+				db[i] = internal.Vehicle{ID: i, Attributes: internal.VehicleAttributes{Brand: "Ford", Registration: string(rune('A' + i))}}
+			}
+			rp := NewVehicleSlice(db, len(db)-1)
+
+			// the vehicles are deleted while the second one is visited
+			var ids []int
+			err := rp.ForEach(func(v internal.Vehicle) (err error) {
+				if v.ID == 1 {
+					for _, id := range tt.deletes {
+						if err = rp.Delete(id); err != nil {
+							return
+						}
+					}
+				}
+				ids = append(ids, v.ID)
+				return
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// - every vehicle is still visited once
+			if want := []int{0, 1, 2, 3, 4}; !slices.Equal(ids, want) {
+				t.Errorf("ids = %v, want %v", ids, want)
+			}
+
+			// - and the deleted ones are not visited anymore
+			ids = nil
+			_ = rp.ForEach(func(v internal.Vehicle) (err error) {
+				ids = append(ids, v.ID)
+				return
+			})
+			for _, id := range tt.deletes {
+				if slices.Contains(ids, id) {
+					t.Errorf("ids = %v, want %d deleted", ids, id)
+				}
+			}
+			if len(ids) != 5-len(tt.deletes) {
+				t.Errorf("ids = %v, want %d vehicles", ids, 5-len(tt.deletes))
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"app/internal"
+	"sort"
+	"sync"
+)
+
+// webhookDeliveriesKept is the number of deliveries kept per webhook.
+const webhookDeliveriesKept = 100
+
+// NewWebhookMap returns a new instance of a webhook repository in a map.
+func NewWebhookMap() *WebhookMap {
+	return &WebhookMap{
+		db:          make(map[int]internal.Webhook),
+		deliveries:  make(map[int][]internal.WebhookDelivery),
+		deadLetters: make(map[int][]internal.WebhookDeadLetter),
+	}
+}
+
+// WebhookMap is an struct that represents a webhook repository in a map.
+type WebhookMap struct {
+	// mu guards the fields below, deliveries are saved concurrently.
+	mu sync.RWMutex
+	// db is the database of webhooks by id.
+	db map[int]internal.Webhook
+	// lastId is the last id of the database.
+	lastId int
+	// deliveries are the last attempts by webhook id, oldest first.
+	deliveries map[int][]internal.WebhookDelivery
+	// lastDeliveryId is the last id of the deliveries.
+	lastDeliveryId int
+	// deadLetters are the dead letters by webhook id.
+	deadLetters map[int][]internal.WebhookDeadLetter
+	// lastDeadLetterId is the last id of the dead letters.
+	lastDeadLetterId int
+}
+
+// FindAll returns all webhooks, ordered by id.
+func (r *WebhookMap) FindAll() (w []internal.Webhook, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	w = make([]internal.Webhook, 0, len(r.db))
+	for _, webhook := range r.db {
+		w = append(w, webhook)
+	}
+	sort.Slice(w, func(i, j int) bool { return w[i].ID < w[j].ID })
+	return
+}
+
+// FindById returns the webhook with the given id.
+func (r *WebhookMap) FindById(id int) (w internal.Webhook, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	w, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+	}
+	return
+}
+
+// Insert saves a new webhook, assigning its id.
+func (r *WebhookMap) Insert(w internal.Webhook) (nw internal.Webhook, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastId++
+	w.ID = r.lastId
+	r.db[w.ID] = w
+	nw = w
+	return
+}
+
+// Update replaces the attributes of a webhook.
+func (r *WebhookMap) Update(id int, a internal.WebhookAttributes) (uw internal.Webhook, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uw, ok := r.db[id]
+	if !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	uw.Attributes = a
+	r.db[id] = uw
+	return
+}
+
+// Delete removes a webhook with its deliveries and dead letters.
+func (r *WebhookMap) Delete(id int) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[id]; !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	delete(r.db, id)
+	delete(r.deliveries, id)
+	delete(r.deadLetters, id)
+	return
+}
+
+// InsertDelivery saves an attempt to send an event, only the last ones of each webhook are kept.
+func (r *WebhookMap) InsertDelivery(d internal.WebhookDelivery) (nd internal.WebhookDelivery, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[d.WebhookID]; !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	r.lastDeliveryId++
+	d.ID = r.lastDeliveryId
+	deliveries := append(r.deliveries[d.WebhookID], d)
+	if len(deliveries) > webhookDeliveriesKept {
+		deliveries = deliveries[len(deliveries)-webhookDeliveriesKept:]
+	}
+	r.deliveries[d.WebhookID] = deliveries
+	nd = d
+	return
+}
+
+// FindDeliveries returns the last attempts of a webhook, newest first.
+func (r *WebhookMap) FindDeliveries(webhookId int) (d []internal.WebhookDelivery, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.db[webhookId]; !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	deliveries := r.deliveries[webhookId]
+	d = make([]internal.WebhookDelivery, len(deliveries))
+	for i := range deliveries {
+		d[i] = deliveries[len(deliveries)-1-i]
+	}
+	return
+}
+
+// InsertDeadLetter saves an event that could not be sent.
+func (r *WebhookMap) InsertDeadLetter(dl internal.WebhookDeadLetter) (ndl internal.WebhookDeadLetter, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[dl.WebhookID]; !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	r.lastDeadLetterId++
+	dl.ID = r.lastDeadLetterId
+	r.deadLetters[dl.WebhookID] = append(r.deadLetters[dl.WebhookID], dl)
+	ndl = dl
+	return
+}
+
+// FindDeadLetters returns the dead letters of a webhook, oldest first.
+func (r *WebhookMap) FindDeadLetters(webhookId int) (dl []internal.WebhookDeadLetter, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.db[webhookId]; !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	dl = make([]internal.WebhookDeadLetter, len(r.deadLetters[webhookId]))
+	copy(dl, r.deadLetters[webhookId])
+	return
+}
+
+// DeleteDeadLetter removes a dead letter, returning it.
+func (r *WebhookMap) DeleteDeadLetter(webhookId int, id int) (dl internal.WebhookDeadLetter, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.db[webhookId]; !ok {
+		err = internal.ErrRepositoryWebhookNotFound
+		return
+	}
+	deadLetters := r.deadLetters[webhookId]
+	for i := range deadLetters {
+		if deadLetters[i].ID == id {
+			dl = deadLetters[i]
+			r.deadLetters[webhookId] = append(deadLetters[:i], deadLetters[i+1:]...)
+			return
+		}
+	}
+	err = internal.ErrRepositoryWebhookDeadLetterNotFound
+	return
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NewFleetDefault returns a new instance of a fleet service.
+// The vehicles of the fleets are the ones of rv, vehicles returns a vehicle service for the ones with the given ids.
+func NewFleetDefault(rp internal.RepositoryFleet, rv internal.RepositoryVehicle, vehicles func(ids []int) internal.ServiceVehicle) *FleetDefault {
+	return &FleetDefault{rp: rp, rv: rv, vehicles: vehicles, done: make(chan struct{})}
+}
+
+// FleetDefault is an struct that represents a fleet service.
+type FleetDefault struct {
+	rp internal.RepositoryFleet
+	// rv are the vehicles the fleets group.
+	rv internal.RepositoryVehicle
+	// vehicles returns a vehicle service scoped to some vehicles.
+	vehicles func(ids []int) internal.ServiceVehicle
+	// done is closed when the service stops listening.
+	done chan struct{}
+	// stopOnce guards done.
+	stopOnce sync.Once
+}
+
+// FindAll returns all fleets.
+func (sv *FleetDefault) FindAll() (f []internal.Fleet, err error) {
+	return sv.rp.FindAll()
+}
+
+// FindById returns the fleet with the given id.
+func (sv *FleetDefault) FindById(id int) (f internal.Fleet, err error) {
+	f, err = sv.rp.FindById(id)
+	if err != nil {
+		err = fleetError(err)
+	}
+	return
+}
+
+// Insert creates a fleet without vehicles.
+func (sv *FleetDefault) Insert(a internal.FleetAttributes) (nf internal.Fleet, err error) {
+	if a, err = validateFleet(a); err != nil {
+		return
+	}
+
+	nf, err = sv.rp.Insert(internal.Fleet{Attributes: a, VehicleIDs: []int{}, CreatedAt: time.Now()})
+	if err != nil {
+		err = fleetError(err)
+	}
+	return
+}
+
+// Update replaces the attributes of a fleet, keeping its vehicles.
+func (sv *FleetDefault) Update(id int, a internal.FleetAttributes) (uf internal.Fleet, err error) {
+	if a, err = validateFleet(a); err != nil {
+		return
+	}
+
+	uf, err = sv.rp.Update(id, a)
+	if err != nil {
+		err = fleetError(err)
+	}
+	return
+}
+
+// Delete removes a fleet, its vehicles are kept.
+func (sv *FleetDefault) Delete(id int) (err error) {
+	if err = sv.rp.Delete(id); err != nil {
+		err = fleetError(err)
+	}
+	return
+}
+
+// AddVehicles adds vehicles to a fleet, all of them have to exist.
+// The vehicles deleted while they are added are removed again, as their events may have been seen before.
+func (sv *FleetDefault) AddVehicles(id int, vehicleIds []int) (uf internal.Fleet, err error) {
+	if len(vehicleIds) == 0 {
+		err = fmt.Errorf("%w: there are not any vehicles", internal.ErrServiceInvalidFleetVehicles)
+		return
+	}
+	if _, err = sv.rp.FindById(id); err != nil {
+		err = fleetError(err)
+		return
+	}
+
+	// - every vehicle is checked before any of them is added
+	for _, vid := range vehicleIds {
+		if _, err = sv.rv.FindById(vid); err != nil {
+			if errors.Is(err, internal.ErrRepositoryVehicleNotFound) {
+				err = fmt.Errorf("%w: vehicle %d not found", internal.ErrServiceInvalidFleetVehicles, vid)
+			}
+			return
+		}
+	}
+
+	uf, err = sv.rp.AddVehicles(id, vehicleIds)
+	if err != nil {
+		err = fleetError(err)
+		return
+	}
+
+	// - vehicles deleted meanwhile, their events may have been seen before they were added
+	removed := false
+	for _, vid := range vehicleIds {
+		if _, e := sv.rv.FindById(vid); !errors.Is(e, internal.ErrRepositoryVehicleNotFound) {
+			continue
+		}
+		removed = true
+		if _, err = sv.rp.RemoveVehicle(id, vid); err != nil && !errors.Is(err, internal.ErrRepositoryFleetVehicleNotFound) {
+			err = fleetError(err)
+			return
+		}
+	}
+	if removed {
+		uf, err = sv.FindById(id)
+	}
+	return
+}
+
+// RemoveVehicle removes a vehicle from a fleet, the vehicle is kept.
+func (sv *FleetDefault) RemoveVehicle(id int, vehicleId int) (uf internal.Fleet, err error) {
+	uf, err = sv.rp.RemoveVehicle(id, vehicleId)
+	if err != nil {
+		err = fleetError(err)
+	}
+	return
+}
+
+// Vehicles returns a vehicle service that only sees the vehicles of a fleet when it is called.
+func (sv *FleetDefault) Vehicles(id int) (s internal.ServiceVehicle, err error) {
+	f, err := sv.rp.FindById(id)
+	if err != nil {
+		err = fleetError(err)
+		return
+	}
+	s = sv.vehicles(f.VehicleIDs)
+	return
+}
+
+// Listen starts removing the deleted vehicles of the bus from their fleets, until stop is called.
+// If the bus discards events before they are seen, the vehicles that do not exist anymore are removed instead.
+func (sv *FleetDefault) Listen(bus internal.EventBusVehicle) (stop func()) {
+	filter := internal.VehicleEventFilter{Types: []internal.VehicleEventType{internal.VehicleEventDeleted}}
+	listen(bus, filter, sv.done, func(e internal.VehicleEvent) {
+		_ = sv.rp.RemoveVehicleFromAll(e.VehicleID)
+	}, func(lastId int64) {
+		_ = sv.prune()
+	})
+	return func() {
+		sv.stopOnce.Do(func() { close(sv.done) })
+	}
+}
+
+// prune removes the vehicles that do not exist anymore from every fleet.
+func (sv *FleetDefault) prune() (err error) {
+	fleets, err := sv.rp.FindAll()
+	if err != nil {
+		return
+	}
+	for _, f := range fleets {
+		for _, vid := range f.VehicleIDs {
+			if _, err = sv.rv.FindById(vid); !errors.Is(err, internal.ErrRepositoryVehicleNotFound) {
+				continue
+			}
+			if _, err = sv.rp.RemoveVehicle(f.ID, vid); err != nil && !errors.Is(err, internal.ErrRepositoryFleetNotFound) && !errors.Is(err, internal.ErrRepositoryFleetVehicleNotFound) {
+				return
+			}
+		}
+	}
+	err = nil
+	return
+}
+
+// validateFleet returns the attributes trimmed, or the error of the first invalid one.
+func validateFleet(a internal.FleetAttributes) (va internal.FleetAttributes, err error) {
+	a.Name = strings.Join(strings.Fields(a.Name), " ")
+	a.Description = strings.TrimSpace(a.Description)
+
+	if a.Name == "" {
+		err = internal.ErrServiceInvalidFleetName
+		return
+	}
+
+	va = a
+	return
+}
+
+// fleetError maps the errors of the repository to the ones of the service.
+func fleetError(err error) error {
+	switch {
+	case errors.Is(err, internal.ErrRepositoryFleetNotFound):
+		return internal.ErrServiceFleetNotFound
+	case errors.Is(err, internal.ErrRepositoryFleetNameAlreadyExists):
+		return internal.ErrServiceFleetNameAlreadyExists
+	case errors.Is(err, internal.ErrRepositoryFleetVehicleNotFound):
+		return internal.ErrServiceFleetVehicleNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/eventbus"
+	"app/internal/repository"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// newTestFleets returns a fleet service over three vehicles, with the vehicles repository.
+func newTestFleets(t *testing.T) (sf *FleetDefault, rv internal.RepositoryVehicle) {
+	rv = repository.NewVehicleSlice([]internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Focus", FuelType: "gasoline", MaxSpeed: 200}},
+		{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Mustang", FuelType: "gasoline", MaxSpeed: 250}},
+		{ID: 3, Attributes: internal.VehicleAttributes{Brand: "Nissan", Model: "Leaf", FuelType: "electric", MaxSpeed: 150}},
+	}, 3)
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	sf = NewFleetDefault(repository.NewFleetMap(), rv, func(ids []int) internal.ServiceVehicle {
+		return NewDefault(repository.NewVehicleScope(rv, ids), nil, sr, nil)
+	})
+	return
+}
+
+func TestFleetDefault_Prune(t *testing.T) {
+	sf, rv := newTestFleets(t)
+	for _, ids := range [][]int{{1, 2}, {2, 3}} {
+		f, err := sf.Insert(internal.FleetAttributes{Name: "fleet " + string(rune('0'+ids[0]))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = sf.AddVehicles(f.ID, ids); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the vehicle is deleted without its event being seen
+	if err := rv.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := sf.prune(); err != nil {
+		t.Fatal(err)
+	}
+	fleets, _ := sf.FindAll()
+	if len(fleets) != 2 || !slices.Equal(fleets[0].VehicleIDs, []int{1}) || !slices.Equal(fleets[1].VehicleIDs, []int{3}) {
+		t.Errorf("fleets = %+v, want the vehicle 2 removed from both", fleets)
+	}
+}
+
+func TestFleetDefault_AddVehicles(t *testing.T) {
+	tests := []struct {
+		name string
+		id   int
+		ids  []int
+		err  error
+		want []int
+	}{
+		{"new vehicles", 1, []int{3, 2}, nil, []int{1, 2, 3}},
+		{"vehicles already in the fleet are skipped", 1, []int{1, 1}, nil, []int{1}},
+		{"no vehicles", 1, nil, internal.ErrServiceInvalidFleetVehicles, []int{1}},
+		{"a vehicle not found adds none", 1, []int{2, 9}, internal.ErrServiceInvalidFleetVehicles, []int{1}},
+		{"fleet not found", 9, []int{2}, internal.ErrServiceFleetNotFound, []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sf, _ := newTestFleets(t)
+			f, err := sf.Insert(internal.FleetAttributes{Name: "  Sales   team "})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f.Attributes.Name != "Sales team" {
+				t.Errorf("name = %q", f.Attributes.Name)
+			}
+			if _, err = sf.AddVehicles(f.ID, []int{1}); err != nil {
+				t.Fatal(err)
+			}
+
+			uf, err := sf.AddVehicles(tt.id, tt.ids)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err == nil && !slices.Equal(uf.VehicleIDs, tt.want) {
+				t.Errorf("vehicles = %v, want %v", uf.VehicleIDs, tt.want)
+			}
+			if f, _ = sf.FindById(f.ID); !slices.Equal(f.VehicleIDs, tt.want) {
+				t.Errorf("stored vehicles = %v, want %v", f.VehicleIDs, tt.want)
+			}
+		})
+	}
+}
+
+func TestFleetDefault_Vehicles(t *testing.T) {
+	sf, rv := newTestFleets(t)
+	f, err := sf.Insert(internal.FleetAttributes{Name: "Fords"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sf.AddVehicles(f.ID, []int{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the vehicle service only sees the vehicles of the fleet
+	sv, err := sf.Vehicles(f.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := sv.FindAllByFuelType("electric"); !errors.Is(err, internal.ErrServiceVehiclesNotFound) {
+		t.Errorf("vehicles = %+v, err = %v, want %v", v, err, internal.ErrServiceVehiclesNotFound)
+	}
+	if avg, err := sv.CalculateAverageSpeedByBrand("Ford"); err != nil || avg != 225 {
+		t.Errorf("average speed = %v, %v, want 225", avg, err)
+	}
+
+	// - a removed vehicle is not seen anymore, but it is kept
+	if _, err = sf.RemoveVehicle(f.ID, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sf.RemoveVehicle(f.ID, 2); !errors.Is(err, internal.ErrServiceFleetVehicleNotFound) {
+		t.Errorf("err = %v, want %v", err, internal.ErrServiceFleetVehicleNotFound)
+	}
+	if sv, err = sf.Vehicles(f.ID); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sv.FindAll(); len(v) != 1 || v[0].ID != 1 {
+		t.Errorf("vehicles = %+v, want the vehicle 1", v)
+	}
+	if _, err = rv.FindById(2); err != nil {
+		t.Errorf("err = %v, want the vehicle kept", err)
+	}
+
+	if _, err = sf.Vehicles(9); !errors.Is(err, internal.ErrServiceFleetNotFound) {
+		t.Errorf("err = %v, want %v", err, internal.ErrServiceFleetNotFound)
+	}
+}
+
+func TestFleetDefault_Listen(t *testing.T) {
+	sf, rv := newTestFleets(t)
+	bus := eventbus.NewVehicleMemory(16)
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	sv := NewDefault(rv, bus, sr, nil)
+	t.Cleanup(sf.Listen(bus))
+
+	f, err := sf.Insert(internal.FleetAttributes{Name: "Fords"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sf.AddVehicles(f.ID, []int{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a deleted vehicle is removed from its fleets
+	if err = sv.Delete(2); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, func() bool {
+		f, _ := sf.FindById(f.ID)
+		return slices.Equal(f.VehicleIDs, []int{1})
+	})
+	// - and cannot be added again
+	if _, err = sf.AddVehicles(f.ID, []int{2}); !errors.Is(err, internal.ErrServiceInvalidFleetVehicles) {
+		t.Errorf("err = %v, want %v", err, internal.ErrServiceInvalidFleetVehicles)
+	}
+}
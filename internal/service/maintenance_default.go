@@ -0,0 +1,381 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NewMaintenanceDefault returns a new instance of a maintenance service.
+// The records are of the vehicles of rv, the brands and fuel types of the intervals are validated against rf.
+func NewMaintenanceDefault(rp internal.RepositoryMaintenance, rv internal.RepositoryVehicle, rf internal.ServiceReference) *MaintenanceDefault {
+	return &MaintenanceDefault{rp: rp, rv: rv, rf: rf, done: make(chan struct{})}
+}
+
+// MaintenanceDefault is an struct that represents a maintenance service.
+type MaintenanceDefault struct {
+	rp internal.RepositoryMaintenance
+	// rv are the vehicles the records are of.
+	rv internal.RepositoryVehicle
+	// rf are the vocabularies the brands and fuel types of the intervals are validated against.
+	rf internal.ServiceReference
+	// done is closed when the service stops listening.
+	done chan struct{}
+	// stopOnce guards done.
+	stopOnce sync.Once
+}
+
+// FindByVehicle returns the records of a vehicle by date, of a kind of maintenance if t is not empty.
+func (sv *MaintenanceDefault) FindByVehicle(vehicleId int, t internal.MaintenanceType) (r []internal.MaintenanceRecord, err error) {
+	if t != "" {
+		if t, err = maintenanceType(t); err != nil {
+			return
+		}
+	}
+	if err = sv.vehicle(vehicleId); err != nil {
+		return
+	}
+
+	records, err := sv.rp.FindByVehicle(vehicleId)
+	if err != nil {
+		return
+	}
+	r = make([]internal.MaintenanceRecord, 0, len(records))
+	for _, rc := range records {
+		if t == "" || rc.Attributes.Type == t {
+			r = append(r, rc)
+		}
+	}
+	return
+}
+
+// FindById returns a record of a vehicle.
+func (sv *MaintenanceDefault) FindById(vehicleId int, id int) (r internal.MaintenanceRecord, err error) {
+	if err = sv.vehicle(vehicleId); err != nil {
+		return
+	}
+	return sv.record(vehicleId, id)
+}
+
+// Insert creates a record of a vehicle.
+func (sv *MaintenanceDefault) Insert(vehicleId int, a internal.MaintenanceAttributes) (nr internal.MaintenanceRecord, err error) {
+	if a, err = validateMaintenance(a); err != nil {
+		return
+	}
+	if err = sv.vehicle(vehicleId); err != nil {
+		return
+	}
+
+	nr, err = sv.rp.Insert(internal.MaintenanceRecord{VehicleID: vehicleId, Attributes: a, CreatedAt: time.Now()})
+	return
+}
+
+// Update replaces the attributes of a record of a vehicle.
+func (sv *MaintenanceDefault) Update(vehicleId int, id int, a internal.MaintenanceAttributes) (ur internal.MaintenanceRecord, err error) {
+	if a, err = validateMaintenance(a); err != nil {
+		return
+	}
+	if err = sv.vehicle(vehicleId); err != nil {
+		return
+	}
+	if _, err = sv.record(vehicleId, id); err != nil {
+		return
+	}
+
+	ur, err = sv.rp.Update(id, a)
+	if err != nil {
+		err = maintenanceError(err)
+	}
+	return
+}
+
+// Delete removes a record of a vehicle.
+func (sv *MaintenanceDefault) Delete(vehicleId int, id int) (err error) {
+	if err = sv.vehicle(vehicleId); err != nil {
+		return
+	}
+	if _, err = sv.record(vehicleId, id); err != nil {
+		return
+	}
+
+	if err = sv.rp.Delete(id); err != nil {
+		err = maintenanceError(err)
+	}
+	return
+}
+
+// FindIntervals returns the intervals the maintenance is due at.
+func (sv *MaintenanceDefault) FindIntervals() (i []internal.MaintenanceInterval, err error) {
+	return sv.rp.FindIntervals()
+}
+
+// ReplaceIntervals replaces all the intervals, with the brands and fuel types as their canonical values.
+// There can only be one interval of each kind of maintenance for the same brand and fuel type.
+func (sv *MaintenanceDefault) ReplaceIntervals(i []internal.MaintenanceInterval) (ni []internal.MaintenanceInterval, err error) {
+	ni = make([]internal.MaintenanceInterval, len(i))
+	for n, in := range i {
+		if in.Type, err = maintenanceType(in.Type); err != nil {
+			err = fmt.Errorf("%w: [%d] has an invalid type", internal.ErrServiceInvalidMaintenanceIntervals, n)
+			return
+		}
+		if in.Months < 0 || in.Kilometers < 0 {
+			err = fmt.Errorf("%w: [%d] has negative months or kilometers", internal.ErrServiceInvalidMaintenanceIntervals, n)
+			return
+		}
+		if in.Brand, err = sv.canonical(internal.ReferenceBrands, in.Brand); err != nil {
+			err = fmt.Errorf("%w: [%d] has an invalid brand", internal.ErrServiceInvalidMaintenanceIntervals, n)
+			return
+		}
+		if in.FuelType, err = sv.canonical(internal.ReferenceFuelTypes, in.FuelType); err != nil {
+			err = fmt.Errorf("%w: [%d] has an invalid fuel type", internal.ErrServiceInvalidMaintenanceIntervals, n)
+			return
+		}
+		for _, prev := range ni[:n] {
+			if prev.Type == in.Type && prev.Brand == in.Brand && prev.FuelType == in.FuelType {
+				err = fmt.Errorf("%w: [%d] repeats the type, brand and fuel type of another one", internal.ErrServiceInvalidMaintenanceIntervals, n)
+				return
+			}
+		}
+		ni[n] = in
+	}
+
+	if err = sv.rp.ReplaceIntervals(ni); err != nil {
+		ni = nil
+	}
+	return
+}
+
+// FindAllDue returns the maintenance the vehicles need on the day of the query, or within its days after it.
+// Each kind of maintenance is due by the most specific interval matching the vehicle, counted from its last record:
+// by time when the months have passed and by distance when the highest odometer of the vehicle has passed the kilometers.
+// A vehicle without any record of a kind of maintenance it needs is overdue.
+// The overdue maintenance is first, then the one due first, by vehicle.
+func (sv *MaintenanceDefault) FindAllDue(q internal.MaintenanceDueQuery) (d []internal.MaintenanceDue, err error) {
+	if q.Days < 0 {
+		err = internal.ErrServiceInvalidMaintenanceDueDays
+		return
+	}
+	if q.Type != "" {
+		if q.Type, err = maintenanceType(q.Type); err != nil {
+			return
+		}
+	}
+	date := q.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	date = startOfDay(date)
+	until := date.AddDate(0, 0, q.Days)
+
+	intervals, err := sv.rp.FindIntervals()
+	if err != nil {
+		return
+	}
+	records, err := sv.rp.FindAll()
+	if err != nil {
+		return
+	}
+	// - the records are by date, so the last one of each kind is kept
+	type kind struct {
+		vehicleId int
+		t         internal.MaintenanceType
+	}
+	last := make(map[kind]internal.MaintenanceRecord)
+	odometers := make(map[int]int)
+	for _, rc := range records {
+		last[kind{rc.VehicleID, rc.Attributes.Type}] = rc
+		odometers[rc.VehicleID] = max(odometers[rc.VehicleID], rc.Attributes.Odometer)
+	}
+
+	d = make([]internal.MaintenanceDue, 0)
+	err = sv.rv.ForEach(func(v internal.Vehicle) (err error) {
+		for _, t := range internal.MaintenanceTypes {
+			if q.Type != "" && t != q.Type {
+				continue
+			}
+			in, ok := vehicleInterval(intervals, v.Attributes, t)
+			if !ok || in.Months == 0 && in.Kilometers == 0 {
+				continue
+			}
+
+			due := internal.MaintenanceDue{Vehicle: v, Interval: in, Odometer: odometers[v.ID]}
+			rc, ok := last[kind{v.ID, t}]
+			if !ok {
+				due.Overdue = true
+				d = append(d, due)
+				continue
+			}
+			due.Last = &rc
+			soon := false
+			if in.Months > 0 {
+				due.DueDate = rc.Attributes.Date.AddDate(0, in.Months, 0)
+				due.Overdue = !due.DueDate.After(date)
+				soon = !due.DueDate.After(until)
+			}
+			if in.Kilometers > 0 {
+				due.DueOdometer = rc.Attributes.Odometer + in.Kilometers
+				due.Overdue = due.Overdue || due.Odometer >= due.DueOdometer
+			}
+			if due.Overdue || soon {
+				d = append(d, due)
+			}
+		}
+		return
+	})
+	// - without vehicles nothing is due
+	if errors.Is(err, internal.ErrRepositoryVehiclesNotFound) {
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	// - the maintenance never done has no due date, so it is first among the overdue one
+	sort.SliceStable(d, func(i, j int) bool {
+		if d[i].Overdue != d[j].Overdue {
+			return d[i].Overdue
+		}
+		if !d[i].DueDate.Equal(d[j].DueDate) {
+			return d[i].DueDate.Before(d[j].DueDate)
+		}
+		return d[i].Vehicle.ID < d[j].Vehicle.ID
+	})
+	return
+}
+
+// Listen starts removing the records of the deleted vehicles of the bus, until stop is called.
+// If the bus discards events before they are seen, the records of the vehicles that do not exist anymore are removed instead.
+func (sv *MaintenanceDefault) Listen(bus internal.EventBusVehicle) (stop func()) {
+	filter := internal.VehicleEventFilter{Types: []internal.VehicleEventType{internal.VehicleEventDeleted}}
+	listen(bus, filter, sv.done, func(e internal.VehicleEvent) {
+		_ = sv.rp.DeleteByVehicle(e.VehicleID)
+	}, func(lastId int64) {
+		_ = sv.prune()
+	})
+	return func() {
+		sv.stopOnce.Do(func() { close(sv.done) })
+	}
+}
+
+// prune removes the records of the vehicles that do not exist anymore.
+func (sv *MaintenanceDefault) prune() (err error) {
+	records, err := sv.rp.FindAll()
+	if err != nil {
+		return
+	}
+	pruned := make(map[int]bool)
+	for _, r := range records {
+		if pruned[r.VehicleID] {
+			continue
+		}
+		if _, err = sv.rv.FindById(r.VehicleID); !errors.Is(err, internal.ErrRepositoryVehicleNotFound) {
+			continue
+		}
+		pruned[r.VehicleID] = true
+		if err = sv.rp.DeleteByVehicle(r.VehicleID); err != nil {
+			return
+		}
+	}
+	err = nil
+	return
+}
+
+// vehicle returns an error if the vehicle with the given id does not exist.
+func (sv *MaintenanceDefault) vehicle(id int) (err error) {
+	_, err = sv.rv.FindById(id)
+	if errors.Is(err, internal.ErrRepositoryVehicleNotFound) {
+		err = internal.ErrServiceVehicleNotFound
+	}
+	return
+}
+
+// record returns a record of a vehicle, the records of other vehicles are not found.
+func (sv *MaintenanceDefault) record(vehicleId int, id int) (r internal.MaintenanceRecord, err error) {
+	r, err = sv.rp.FindById(id)
+	if err != nil {
+		err = maintenanceError(err)
+		return
+	}
+	if r.VehicleID != vehicleId {
+		err = internal.ErrServiceMaintenanceNotFound
+	}
+	return
+}
+
+// canonical returns the value of a vocabulary a value is written as, empty if it is empty.
+func (sv *MaintenanceDefault) canonical(k internal.ReferenceKind, v string) (c string, err error) {
+	v = strings.TrimSpace(v)
+	if v == "" || sv.rf == nil {
+		return v, nil
+	}
+	return sv.rf.Canonical(k, v)
+}
+
+// vehicleInterval returns the most specific interval of a kind of maintenance matching a vehicle.
+func vehicleInterval(intervals []internal.MaintenanceInterval, a internal.VehicleAttributes, t internal.MaintenanceType) (in internal.MaintenanceInterval, ok bool) {
+	for _, i := range intervals {
+		if i.Type != t || !i.Matches(a) {
+			continue
+		}
+		if !ok || i.Specificity() > in.Specificity() {
+			in, ok = i, true
+		}
+	}
+	return
+}
+
+// maintenanceType returns a kind of maintenance written in any case.
+func maintenanceType(t internal.MaintenanceType) (mt internal.MaintenanceType, err error) {
+	mt = internal.MaintenanceType(strings.ToLower(strings.TrimSpace(string(t))))
+	if !slices.Contains(internal.MaintenanceTypes, mt) {
+		err = internal.ErrServiceInvalidMaintenanceType
+	}
+	return
+}
+
+// startOfDay returns the day of a time, at midnight UTC.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// validateMaintenance returns the attributes normalized, or the error of the first invalid one.
+// The date is a day that is not in the future, the odometer and cost are not negative.
+func validateMaintenance(a internal.MaintenanceAttributes) (va internal.MaintenanceAttributes, err error) {
+	if a.Date.IsZero() || startOfDay(a.Date).After(startOfDay(time.Now())) {
+		err = internal.ErrServiceInvalidMaintenanceDate
+		return
+	}
+	a.Date = startOfDay(a.Date)
+	if a.Odometer < 0 {
+		err = internal.ErrServiceInvalidMaintenanceOdometer
+		return
+	}
+	if a.Type, err = maintenanceType(a.Type); err != nil {
+		return
+	}
+	if a.Cost < 0 || math.IsNaN(a.Cost) || math.IsInf(a.Cost, 0) {
+		err = internal.ErrServiceInvalidMaintenanceCost
+		return
+	}
+	a.Notes = strings.TrimSpace(a.Notes)
+
+	va = a
+	return
+}
+
+// maintenanceError maps the errors of the repository to the ones of the service.
+func maintenanceError(err error) error {
+	switch {
+	case errors.Is(err, internal.ErrRepositoryMaintenanceNotFound):
+		return internal.ErrServiceMaintenanceNotFound
+	default:
+		return err
+	}
+}
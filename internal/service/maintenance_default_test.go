@@ -0,0 +1,78 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/eventbus"
+	"app/internal/repository"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMaintenanceDefault_DeletedVehicle(t *testing.T) {
+	rv := repository.NewVehicleSlice([]internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Focus", FuelType: "gasoline"}},
+		{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Mustang", FuelType: "gasoline"}},
+	}, 2)
+	bus := eventbus.NewVehicleMemory(16)
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	rm := repository.NewMaintenanceMap(nil)
+	sm := NewMaintenanceDefault(rm, rv, sr)
+	sv := NewDefault(rv, bus, sr, repository.NewOwnerMap())
+	t.Cleanup(sm.Listen(bus))
+
+	a := internal.MaintenanceAttributes{Date: time.Now().AddDate(0, -1, 0), Odometer: 1000, Type: internal.MaintenanceOilChange, Cost: 80}
+	for _, id := range []int{1, 1, 2} {
+		if _, err := sm.Insert(id, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the records of a deleted vehicle are deleted with it
+	if err := sv.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, func() bool {
+		r, _ := rm.FindByVehicle(1)
+		return len(r) == 0
+	})
+	if r, _ := rm.FindAll(); len(r) != 1 || r[0].VehicleID != 2 {
+		t.Errorf("records = %+v, want the one of vehicle 2", r)
+	}
+
+	// and the vehicle is not found anymore
+	if _, err := sm.FindByVehicle(1, ""); !errors.Is(err, internal.ErrServiceVehicleNotFound) {
+		t.Errorf("err = %v, want %v", err, internal.ErrServiceVehicleNotFound)
+	}
+	if _, err := sm.Insert(1, a); !errors.Is(err, internal.ErrServiceVehicleNotFound) {
+		t.Errorf("err = %v, want %v", err, internal.ErrServiceVehicleNotFound)
+	}
+}
+
+func TestMaintenanceDefault_Prune(t *testing.T) {
+	rv := repository.NewVehicleSlice([]internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Focus", FuelType: "gasoline"}},
+		{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Mustang", FuelType: "gasoline"}},
+	}, 2)
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	rm := repository.NewMaintenanceMap(nil)
+	sm := NewMaintenanceDefault(rm, rv, sr)
+
+	a := internal.MaintenanceAttributes{Date: time.Now().AddDate(0, -1, 0), Odometer: 1000, Type: internal.MaintenanceOilChange, Cost: 80}
+	for _, id := range []int{1, 1, 2} {
+		if _, err := sm.Insert(id, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the vehicle is deleted without its event being seen
+	if err := rv.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.prune(); err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := rm.FindAll(); len(r) != 1 || r[0].VehicleID != 2 {
+		t.Errorf("records = %+v, want the one of vehicle 2", r)
+	}
+}
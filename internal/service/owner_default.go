@@ -0,0 +1,152 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// NewOwnerDefault returns a new instance of an owner service.
+// The vehicles of the owners are the ones of rv.
+func NewOwnerDefault(rp internal.RepositoryOwner, rv internal.RepositoryVehicle) *OwnerDefault {
+	return &OwnerDefault{rp: rp, rv: rv}
+}
+
+// OwnerDefault is an struct that represents an owner service.
+type OwnerDefault struct {
+	rp internal.RepositoryOwner
+	// rv are the vehicles, which refer to their owners.
+	rv internal.RepositoryVehicle
+}
+
+// FindAll returns all owners.
+func (sv *OwnerDefault) FindAll() (o []internal.Owner, err error) {
+	return sv.rp.FindAll()
+}
+
+// FindById returns the owner with the given id.
+func (sv *OwnerDefault) FindById(id int) (o internal.Owner, err error) {
+	o, err = sv.rp.FindById(id)
+	if err != nil {
+		err = ownerError(err)
+	}
+	return
+}
+
+// Insert creates an owner.
+func (sv *OwnerDefault) Insert(a internal.OwnerAttributes) (no internal.Owner, err error) {
+	if a, err = validateOwner(a); err != nil {
+		return
+	}
+
+	no, err = sv.rp.Insert(internal.Owner{Attributes: a, CreatedAt: time.Now()})
+	return
+}
+
+// Update replaces the attributes of an owner.
+func (sv *OwnerDefault) Update(id int, a internal.OwnerAttributes) (uo internal.Owner, err error) {
+	if a, err = validateOwner(a); err != nil {
+		return
+	}
+
+	uo, err = sv.rp.Update(id, a)
+	if err != nil {
+		err = ownerError(err)
+	}
+	return
+}
+
+// Delete removes an owner, it fails if any vehicle still belongs to the owner.
+// No vehicle can be given the owner while its vehicles are looked for.
+func (sv *OwnerDefault) Delete(id int) (err error) {
+	err = sv.rp.DeleteIf(id, func() (err error) {
+		vehicles, err := sv.vehicles(id)
+		if err != nil {
+			return
+		}
+		if len(vehicles) > 0 {
+			err = internal.ErrServiceOwnerHasVehicles
+		}
+		return
+	})
+	if err != nil {
+		err = ownerError(err)
+	}
+	return
+}
+
+// FindVehicles returns the vehicles of an owner, ordered by id.
+func (sv *OwnerDefault) FindVehicles(id int) (v []internal.Vehicle, err error) {
+	if _, err = sv.rp.FindById(id); err != nil {
+		err = ownerError(err)
+		return
+	}
+	return sv.vehicles(id)
+}
+
+// vehicles returns the vehicles that belong to an owner.
+func (sv *OwnerDefault) vehicles(id int) (v []internal.Vehicle, err error) {
+	v = make([]internal.Vehicle, 0)
+	err = sv.rv.ForEach(func(vh internal.Vehicle) (err error) {
+		if vh.OwnerID == id {
+			v = append(v, vh)
+		}
+		return
+	})
+	// - an empty repository has no vehicles of the owner
+	if errors.Is(err, internal.ErrRepositoryVehiclesNotFound) {
+		err = nil
+	}
+	return
+}
+
+// validateOwner returns the attributes trimmed, or the error of the first invalid one.
+// The name and email are required, the phone can only have digits, spaces and + - ( ) characters.
+func validateOwner(a internal.OwnerAttributes) (va internal.OwnerAttributes, err error) {
+	a.Name = strings.Join(strings.Fields(a.Name), " ")
+	a.Email = strings.TrimSpace(a.Email)
+	a.Phone = strings.TrimSpace(a.Phone)
+
+	if a.Name == "" {
+		err = internal.ErrServiceInvalidOwnerName
+		return
+	}
+	// - only plain addresses, without a display name
+	if addr, e := mail.ParseAddress(a.Email); e != nil || addr.Address != a.Email {
+		err = internal.ErrServiceInvalidOwnerEmail
+		return
+	}
+	if a.Phone != "" {
+		digits := 0
+		for _, r := range a.Phone {
+			switch {
+			case unicode.IsDigit(r):
+				digits++
+			case r == ' ' || r == '+' || r == '-' || r == '(' || r == ')':
+			default:
+				err = internal.ErrServiceInvalidOwnerPhone
+				return
+			}
+		}
+		if digits < 6 {
+			err = internal.ErrServiceInvalidOwnerPhone
+			return
+		}
+	}
+
+	va = a
+	return
+}
+
+// ownerError maps the errors of the repository to the ones of the service.
+func ownerError(err error) error {
+	switch {
+	case errors.Is(err, internal.ErrRepositoryOwnerNotFound):
+		return internal.ErrServiceOwnerNotFound
+	default:
+		return err
+	}
+}
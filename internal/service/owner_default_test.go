@@ -0,0 +1,92 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/repository"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// newTestOwners returns an owner service and a vehicle service sharing the owners and the vehicles,
+// the vehicle 1 belonging to the owner 1.
+func newTestOwners(t *testing.T) (so *OwnerDefault, sv *Default, rv internal.RepositoryVehicle) {
+	ro := repository.NewOwnerMap()
+	for _, name := range []string{"Ana", "Bruno"} {
+		if _, err := ro.Insert(internal.Owner{Attributes: internal.OwnerAttributes{Name: name, Email: name + "@example.com"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rv = repository.NewVehicleSlice([]internal.Vehicle{
+		{ID: 1, OwnerID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Focus", FuelType: "gasoline"}},
+		{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", Model: "Mustang", FuelType: "gasoline"}},
+	}, 2)
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	return NewOwnerDefault(ro, rv), NewDefault(rv, nil, sr, ro), rv
+}
+
+func TestOwnerDefault_Delete(t *testing.T) {
+	tests := []struct {
+		name string
+		id   int
+		err  error
+	}{
+		{"with vehicles", 1, internal.ErrServiceOwnerHasVehicles},
+		{"without vehicles", 2, nil},
+		{"not found", 3, internal.ErrServiceOwnerNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			so, sv, _ := newTestOwners(t)
+
+			err := so.Delete(tt.id)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			_, err = so.FindById(tt.id)
+			if deleted := errors.Is(err, internal.ErrServiceOwnerNotFound); deleted != (tt.err == nil || tt.id == 3) {
+				t.Errorf("err = %v, the owner deleted is %t", err, deleted)
+			}
+			// - a deleted owner cannot be given to a vehicle
+			if tt.err == nil {
+				if _, err = sv.UpdateOwnerById(2, tt.id); !errors.Is(err, internal.ErrServiceInvalidVehicleOwner) {
+					t.Errorf("err = %v, want %v", err, internal.ErrServiceInvalidVehicleOwner)
+				}
+			}
+		})
+	}
+}
+
+func TestOwnerDefault_DeleteWhileAssigned(t *testing.T) {
+	// the owner is either deleted or given to the vehicle, never both
+	for i := 0; i < 100; i++ {
+		so, sv, rv := newTestOwners(t)
+
+		var wg sync.WaitGroup
+		var errDelete, errUpdate error
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			errDelete = so.Delete(2)
+		}()
+		go func() {
+			defer wg.Done()
+			_, errUpdate = sv.UpdateOwnerById(2, 2)
+		}()
+		wg.Wait()
+
+		v, err := rv.FindById(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, errOwner := so.FindById(2)
+		switch {
+		case errDelete == nil && errUpdate == nil:
+			t.Fatalf("the owner was deleted and given to the vehicle")
+		case errDelete == nil && (v.OwnerID != 0 || !errors.Is(errUpdate, internal.ErrServiceInvalidVehicleOwner)):
+			t.Fatalf("vehicle = %+v, err = %v after the owner was deleted", v, errUpdate)
+		case errUpdate == nil && (v.OwnerID != 2 || errOwner != nil || !errors.Is(errDelete, internal.ErrServiceOwnerHasVehicles)):
+			t.Fatalf("vehicle = %+v, owner err = %v, delete err = %v after the owner was given", v, errOwner, errDelete)
+		}
+	}
+}
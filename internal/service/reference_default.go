@@ -0,0 +1,64 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"slices"
+)
+
+// NewReferenceDefault returns a new instance of a service of controlled vocabularies.
+func NewReferenceDefault(rp internal.RepositoryReference) *ReferenceDefault {
+	return &ReferenceDefault{rp: rp}
+}
+
+// ReferenceDefault is an struct that represents a service of controlled vocabularies.
+type ReferenceDefault struct {
+	rp internal.RepositoryReference
+}
+
+// FindAll returns the values of a vocabulary, sorted.
+func (sv *ReferenceDefault) FindAll(k internal.ReferenceKind) (v []string, err error) {
+	if !slices.Contains(internal.ReferenceKinds, k) {
+		err = internal.ErrServiceReferenceKindNotFound
+		return
+	}
+	return sv.rp.FindAll(k)
+}
+
+// Insert adds a value to a vocabulary, normalized as the attribute of the vehicles (e.g. Electric is electric).
+func (sv *ReferenceDefault) Insert(k internal.ReferenceKind, v string) (nv string, err error) {
+	if !slices.Contains(internal.ReferenceKinds, k) {
+		err = internal.ErrServiceReferenceKindNotFound
+		return
+	}
+	nv = internal.NormalizeReference(k, v)
+	if nv == "" {
+		err = internal.ErrServiceInvalidReferenceValue
+		return
+	}
+
+	if err = sv.rp.Insert(k, nv); err != nil {
+		if errors.Is(err, internal.ErrRepositoryReferenceAlreadyExists) {
+			err = internal.ErrServiceReferenceAlreadyExists
+		}
+		return "", err
+	}
+	return
+}
+
+// Canonical returns the value of a vocabulary a value is written as, once normalized and ignoring case (e.g. Gas is gasoline).
+func (sv *ReferenceDefault) Canonical(k internal.ReferenceKind, v string) (c string, err error) {
+	if !slices.Contains(internal.ReferenceKinds, k) {
+		err = internal.ErrServiceReferenceKindNotFound
+		return
+	}
+
+	c, err = sv.rp.Find(k, internal.NormalizeReference(k, v))
+	if err != nil {
+		if errors.Is(err, internal.ErrRepositoryReferenceNotFound) {
+			err = internal.ErrServiceReferenceNotFound
+		}
+		return
+	}
+	return
+}
@@ -4,16 +4,128 @@ import (
 	"app/internal"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // NewDefault returns a new instance of a vehicle service.
-func NewDefault(rp internal.RepositoryVehicle) *Default {
-	return &Default{rp: rp}
+// The changes of vehicles are published to pb, if not nil.
+// Brands, colors, fuel types and transmissions are validated against the vocabularies of rf, the default ones if nil.
+// The owners of vehicles must be in ro, vehicles cannot have owners if it is nil.
+func NewDefault(rp internal.RepositoryVehicle, pb internal.EventPublisherVehicle, rf internal.ServiceReference, ro internal.RepositoryOwner) *Default {
+	return &Default{rp: rp, pb: pb, rf: rf, ro: ro}
 }
 
 // Default is an struct that represents a vehicle service.
 type Default struct {
 	rp internal.RepositoryVehicle
+	// pb is where the changes of vehicles are published.
+	pb internal.EventPublisherVehicle
+	// rf are the vocabularies the categorical attributes are validated against.
+	rf internal.ServiceReference
+	// ro are the owners vehicles can belong to.
+	ro internal.RepositoryOwner
+}
+
+// vehicleAttributeNames are the names of all the attributes of a vehicle, the changes of a created vehicle.
+var vehicleAttributeNames = []string{"brand", "model", "registration", "year", "color", "max_speed", "fuel_type", "transmission", "passengers", "height", "width", "weight", "battery_capacity", "range", "charging_connector"}
+
+// publish sends an event of a vehicle change, if there is a publisher.
+func (sv *Default) publish(t internal.VehicleEventType, v internal.Vehicle, changes ...string) {
+	if sv.pb == nil {
+		return
+	}
+	sv.pb.Publish(internal.VehicleEvent{
+		Type:      t,
+		VehicleID: v.ID,
+		Changes:   changes,
+		Vehicle:   v,
+	})
+}
+
+// owners calls fn while the owners vehicles are given cannot be deleted, 0 being no owner.
+// It returns an error instead if a vehicle cannot belong to one of the owners.
+func (sv *Default) owners(ids []int, fn func() (err error)) (err error) {
+	used := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			continue
+		}
+		if id < 0 || sv.ro == nil {
+			return internal.ErrServiceInvalidVehicleOwner
+		}
+		used = append(used, id)
+	}
+	if len(used) == 0 {
+		return fn()
+	}
+
+	var called bool
+	err = sv.ro.Use(used, func() (err error) {
+		called = true
+		return fn()
+	})
+	if !called && errors.Is(err, internal.ErrRepositoryOwnerNotFound) {
+		err = fmt.Errorf("%w. %v", internal.ErrServiceInvalidVehicleOwner, err)
+	}
+	return
+}
+
+// canonical replaces a value by the one of a vocabulary it is written as, reporting whether it is in the vocabulary.
+func (sv *Default) canonical(k internal.ReferenceKind, v *string) bool {
+	if sv.rf == nil {
+		for _, r := range internal.DefaultReferences()[k] {
+			if strings.EqualFold(r, *v) {
+				*v = r
+				return true
+			}
+		}
+		return false
+	}
+
+	c, err := sv.rf.Canonical(k, *v)
+	if err != nil {
+		return false
+	}
+	*v = c
+	return true
+}
+
+// energy validates the battery capacity, range and charging connector of a vehicle by its fuel type:
+// electric vehicles have all of them, hybrid ones a battery (plug-in hybrids a connector too) and others none of them.
+func (sv *Default) energy(a *internal.VehicleAttributes) (err error) {
+	switch internal.FuelType(a.FuelType) {
+	case internal.FuelTypeElectric:
+		if a.BatteryCapacity <= 0 {
+			return internal.ErrServiceInvalidVehicleBatteryCapacity
+		}
+		if a.Range < 1 {
+			return internal.ErrServiceInvalidVehicleRange
+		}
+		if !sv.canonical(internal.ReferenceChargingConnectors, &a.ChargingConnector) {
+			return internal.ErrServiceInvalidVehicleChargingConnector
+		}
+	case internal.FuelTypeHybrid:
+		if a.BatteryCapacity <= 0 {
+			return internal.ErrServiceInvalidVehicleBatteryCapacity
+		}
+		if a.Range < 0 {
+			return internal.ErrServiceInvalidVehicleRange
+		}
+		if a.ChargingConnector != "" && !sv.canonical(internal.ReferenceChargingConnectors, &a.ChargingConnector) {
+			return internal.ErrServiceInvalidVehicleChargingConnector
+		}
+	default:
+		if a.BatteryCapacity != 0 {
+			return internal.ErrServiceInvalidVehicleBatteryCapacity
+		}
+		if a.Range != 0 {
+			return internal.ErrServiceInvalidVehicleRange
+		}
+		if a.ChargingConnector != "" {
+			return internal.ErrServiceInvalidVehicleChargingConnector
+		}
+	}
+	return nil
 }
 
 // FindAll returns all vehicles.
@@ -32,7 +144,10 @@ func (sv *Default) FindAll() (v []internal.Vehicle, err error) {
 }
 
 func (sv *Default) Insert(v internal.Vehicle) (nv internal.Vehicle, err error) {
-	if v.Attributes.Brand == "" {
+	// canonical values are stored (e.g. gas is stored as gasoline)
+	v.Attributes = internal.NormalizeVehicleAttributes(v.Attributes)
+
+	if !sv.canonical(internal.ReferenceBrands, &v.Attributes.Brand) {
 		err = internal.ErrServiceInvalidVehicleBrand
 		return
 	}
@@ -48,7 +163,7 @@ func (sv *Default) Insert(v internal.Vehicle) (nv internal.Vehicle, err error) {
 		err = internal.ErrServiceInvalidVehicleYear
 		return
 	}
-	if v.Attributes.Color == "" {
+	if !sv.canonical(internal.ReferenceColors, &v.Attributes.Color) {
 		err = internal.ErrServiceInvalidVehicleColor
 		return
 	}
@@ -56,11 +171,11 @@ func (sv *Default) Insert(v internal.Vehicle) (nv internal.Vehicle, err error) {
 		err = internal.ErrServiceInvalidVehicleMaxSpeed
 		return
 	}
-	if v.Attributes.FuelType == "" || (v.Attributes.FuelType != "gasoline" && v.Attributes.FuelType != "gas" && v.Attributes.FuelType != "diesel" && v.Attributes.FuelType != "biodiesel") {
+	if !sv.canonical(internal.ReferenceFuelTypes, &v.Attributes.FuelType) {
 		err = internal.ErrServiceInvalidVehicleFuelType
 		return
 	}
-	if v.Attributes.Transmission == "" || (v.Attributes.Transmission != "automatic" && v.Attributes.Transmission != "semi-automatic" && v.Attributes.Transmission != "manual") {
+	if !sv.canonical(internal.ReferenceTransmissions, &v.Attributes.Transmission) {
 		err = internal.ErrServiceInvalidVehicleTransmission
 		return
 	}
@@ -80,8 +195,14 @@ func (sv *Default) Insert(v internal.Vehicle) (nv internal.Vehicle, err error) {
 		err = internal.ErrServiceInvalidVehicleWeight
 		return
 	}
+	if err = sv.energy(&v.Attributes); err != nil {
+		return
+	}
 
-	nv, err = sv.rp.Insert(v)
+	err = sv.owners([]int{v.OwnerID}, func() (err error) {
+		nv, err = sv.rp.Insert(v)
+		return
+	})
 	if err != nil {
 		switch {
 		case errors.Is(err, internal.ErrRepositoryVehicleIdAlreadyExists):
@@ -90,11 +211,13 @@ func (sv *Default) Insert(v internal.Vehicle) (nv internal.Vehicle, err error) {
 			return internal.Vehicle{}, err
 		}
 	}
+	sv.publish(internal.VehicleEventCreated, nv, vehicleAttributeNames...)
 
 	return nv, nil
 }
 
 func (sv *Default) FindAllByColorAndYear(c string, y int) (v []internal.Vehicle, err error) {
+	c = internal.NormalizeColor(c)
 	if c == "" {
 		err = internal.ErrServiceInvalidVehicleColor
 		return
@@ -116,7 +239,7 @@ func (sv *Default) FindAllByColorAndYear(c string, y int) (v []internal.Vehicle,
 
 	vehiclesWithColorAndYear := make([]internal.Vehicle, 0)
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Color == c && vehicle.Attributes.Year == y {
+		if strings.EqualFold(vehicle.Attributes.Color, c) && vehicle.Attributes.Year == y {
 			vehiclesWithColorAndYear = append(vehiclesWithColorAndYear, vehicle)
 		}
 	}
@@ -128,12 +251,13 @@ func (sv *Default) FindAllByColorAndYear(c string, y int) (v []internal.Vehicle,
 	return vehiclesWithColorAndYear, nil
 }
 
-func (sv *Default) FindAllByBrandAndBetweenYears(b string, sy int, ey int) (v []internal.Vehicle, err error) {
+func (sv *Default) FindAllByBrandAndBetweenYears(b string, years internal.Interval) (v []internal.Vehicle, err error) {
+	b = internal.NormalizeBrand(b)
 	if b == "" {
 		err = internal.ErrServiceInvalidVehicleBrand
 		return
 	}
-	if sy < 1887 || ey < sy {
+	if !validInterval(years, 1887) {
 		err = internal.ErrServiceInvalidVehicleYear
 		return
 	}
@@ -150,7 +274,7 @@ func (sv *Default) FindAllByBrandAndBetweenYears(b string, sy int, ey int) (v []
 
 	vehiclesWithColorAndBetweenYears := make([]internal.Vehicle, 0)
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Brand == b && vehicle.Attributes.Year > sy && vehicle.Attributes.Year < ey {
+		if strings.EqualFold(vehicle.Attributes.Brand, b) && years.Contains(float64(vehicle.Attributes.Year)) {
 			vehiclesWithColorAndBetweenYears = append(vehiclesWithColorAndBetweenYears, vehicle)
 		}
 	}
@@ -163,6 +287,7 @@ func (sv *Default) FindAllByBrandAndBetweenYears(b string, sy int, ey int) (v []
 }
 
 func (sv *Default) CalculateAverageSpeedByBrand(b string) (avg float64, err error) {
+	b = internal.NormalizeBrand(b)
 	if b == "" {
 		err = internal.ErrServiceInvalidVehicleBrand
 		return
@@ -181,7 +306,7 @@ func (sv *Default) CalculateAverageSpeedByBrand(b string) (avg float64, err erro
 	vehiclesBrand := make([]internal.Vehicle, 0)
 	maxSpeedSum := 0
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Brand == b {
+		if strings.EqualFold(vehicle.Attributes.Brand, b) {
 			vehiclesBrand = append(vehiclesBrand, vehicle)
 			maxSpeedSum += vehicle.Attributes.MaxSpeed
 		}
@@ -197,8 +322,16 @@ func (sv *Default) CalculateAverageSpeedByBrand(b string) (avg float64, err erro
 }
 
 func (sv *Default) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle, err error) {
-	for _, vh := range v {
-		if vh.Attributes.Brand == "" {
+	// canonical values are stored, without changing the vehicles of the caller
+	v = append([]internal.Vehicle(nil), v...)
+	for i := range v {
+		v[i].Attributes = internal.NormalizeVehicleAttributes(v[i].Attributes)
+	}
+
+	ownerIds := make([]int, 0, len(v))
+	for i := range v {
+		vh := &v[i]
+		if !sv.canonical(internal.ReferenceBrands, &vh.Attributes.Brand) {
 			err = internal.ErrServiceInvalidVehicleBrand
 			return
 		}
@@ -214,7 +347,7 @@ func (sv *Default) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle, err
 			err = internal.ErrServiceInvalidVehicleYear
 			return
 		}
-		if vh.Attributes.Color == "" {
+		if !sv.canonical(internal.ReferenceColors, &vh.Attributes.Color) {
 			err = internal.ErrServiceInvalidVehicleColor
 			return
 		}
@@ -222,11 +355,11 @@ func (sv *Default) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle, err
 			err = internal.ErrServiceInvalidVehicleMaxSpeed
 			return
 		}
-		if vh.Attributes.FuelType == "" || (vh.Attributes.FuelType != "gasoline" && vh.Attributes.FuelType != "gas" && vh.Attributes.FuelType != "diesel" && vh.Attributes.FuelType != "biodiesel") {
+		if !sv.canonical(internal.ReferenceFuelTypes, &vh.Attributes.FuelType) {
 			err = internal.ErrServiceInvalidVehicleFuelType
 			return
 		}
-		if vh.Attributes.Transmission == "" || (vh.Attributes.Transmission != "automatic" && vh.Attributes.Transmission != "semi-automatic" && vh.Attributes.Transmission != "manual") {
+		if !sv.canonical(internal.ReferenceTransmissions, &vh.Attributes.Transmission) {
 			err = internal.ErrServiceInvalidVehicleTransmission
 			return
 		}
@@ -246,9 +379,16 @@ func (sv *Default) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle, err
 			err = internal.ErrServiceInvalidVehicleWeight
 			return
 		}
+		if err = sv.energy(&vh.Attributes); err != nil {
+			return
+		}
+		ownerIds = append(ownerIds, vh.OwnerID)
 	}
 
-	nvs, err = sv.rp.InsertMany(v)
+	err = sv.owners(ownerIds, func() (err error) {
+		nvs, err = sv.rp.InsertMany(v)
+		return
+	})
 	if err != nil {
 		switch {
 		case errors.Is(err, internal.ErrRepositoryVehicleIdAlreadyExists):
@@ -257,6 +397,9 @@ func (sv *Default) InsertMany(v []internal.Vehicle) (nvs []internal.Vehicle, err
 			return nil, err
 		}
 	}
+	for _, nv := range nvs {
+		sv.publish(internal.VehicleEventCreated, nv, vehicleAttributeNames...)
+	}
 
 	return nvs, nil
 }
@@ -276,11 +419,13 @@ func (sv *Default) UpdateMaxSpeedById(id int, ms int) (uv internal.Vehicle, err
 			return internal.Vehicle{}, err
 		}
 	}
+	sv.publish(internal.VehicleEventUpdated, uv, "max_speed")
 	return uv, nil
 }
 
 func (sv *Default) FindAllByFuelType(ft string) (v []internal.Vehicle, err error) {
-	if ft == "" || (ft != "gasoline" && ft != "gas" && ft != "diesel" && ft != "biodiesel") {
+	ft = internal.NormalizeFuelType(ft)
+	if !sv.canonical(internal.ReferenceFuelTypes, &ft) {
 		err = internal.ErrServiceInvalidVehicleFuelType
 		return
 	}
@@ -297,7 +442,7 @@ func (sv *Default) FindAllByFuelType(ft string) (v []internal.Vehicle, err error
 
 	vehiclesByFuelType := make([]internal.Vehicle, 0)
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.FuelType == ft {
+		if strings.EqualFold(vehicle.Attributes.FuelType, ft) {
 			vehiclesByFuelType = append(vehiclesByFuelType, vehicle)
 		}
 	}
@@ -318,11 +463,13 @@ func (sv *Default) Delete(id int) (err error) {
 			return err
 		}
 	}
+	sv.publish(internal.VehicleEventDeleted, internal.Vehicle{ID: id})
 	return nil
 }
 
 func (sv *Default) FindAllByTransmission(t string) (v []internal.Vehicle, err error) {
-	if t == "" || (t != "automatic" && t != "semi-automatic" && t != "manual") {
+	t = internal.NormalizeTransmission(t)
+	if !sv.canonical(internal.ReferenceTransmissions, &t) {
 		err = internal.ErrServiceInvalidVehicleTransmission
 		return
 	}
@@ -339,7 +486,7 @@ func (sv *Default) FindAllByTransmission(t string) (v []internal.Vehicle, err er
 
 	vehiclesByTransmission := make([]internal.Vehicle, 0)
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Transmission == t {
+		if strings.EqualFold(vehicle.Attributes.Transmission, t) {
 			vehiclesByTransmission = append(vehiclesByTransmission, vehicle)
 		}
 	}
@@ -351,13 +498,42 @@ func (sv *Default) FindAllByTransmission(t string) (v []internal.Vehicle, err er
 	return vehiclesByTransmission, nil
 }
 
-func (sv *Default) UpdateFuelTypeById(id int, ft string) (uv internal.Vehicle, err error) {
-	if ft == "" || (ft != "gasoline" && ft != "gas" && ft != "diesel" && ft != "biodiesel") {
+// UpdateFuelTypeById sets the fuel type of a vehicle with the battery capacity, range and charging connector of f,
+// which replace the ones it had and must fit the fuel type (e.g. a gasoline vehicle has not any battery).
+func (sv *Default) UpdateFuelTypeById(id int, f internal.VehicleFuel) (uv internal.Vehicle, err error) {
+	f.FuelType = internal.NormalizeFuelType(f.FuelType)
+	if !sv.canonical(internal.ReferenceFuelTypes, &f.FuelType) {
 		err = internal.ErrServiceInvalidVehicleFuelType
 		return
 	}
+	f.ChargingConnector = internal.NormalizeChargingConnector(f.ChargingConnector)
+
+	// the energy attributes are validated by the repository while the vehicle cannot change
+	var changes []string
+	uv, err = sv.rp.UpdateFuelTypeById(id, func(a *internal.VehicleAttributes) (err error) {
+		previous := *a
+		a.FuelType = f.FuelType
+		a.BatteryCapacity = f.BatteryCapacity
+		a.Range = f.Range
+		a.ChargingConnector = f.ChargingConnector
+		if err = sv.energy(a); err != nil {
+			return
+		}
 
-	uv, err = sv.rp.UpdateFuelTypeById(id, ft)
+		if a.FuelType != previous.FuelType {
+			changes = append(changes, "fuel_type")
+		}
+		if a.BatteryCapacity != previous.BatteryCapacity {
+			changes = append(changes, "battery_capacity")
+		}
+		if a.Range != previous.Range {
+			changes = append(changes, "range")
+		}
+		if a.ChargingConnector != previous.ChargingConnector {
+			changes = append(changes, "charging_connector")
+		}
+		return
+	})
 	if err != nil {
 		switch {
 		case errors.Is(err, internal.ErrRepositoryVehicleNotFound):
@@ -366,10 +542,29 @@ func (sv *Default) UpdateFuelTypeById(id int, ft string) (uv internal.Vehicle, e
 			return internal.Vehicle{}, err
 		}
 	}
+	sv.publish(internal.VehicleEventUpdated, uv, changes...)
+	return uv, nil
+}
+
+func (sv *Default) UpdateOwnerById(id int, ownerId int) (uv internal.Vehicle, err error) {
+	err = sv.owners([]int{ownerId}, func() (err error) {
+		uv, err = sv.rp.UpdateOwnerById(id, ownerId)
+		return
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrRepositoryVehicleNotFound):
+			return internal.Vehicle{}, internal.ErrServiceVehicleNotFound
+		default:
+			return internal.Vehicle{}, err
+		}
+	}
+	sv.publish(internal.VehicleEventUpdated, uv, "owner_id")
 	return uv, nil
 }
 
 func (sv *Default) CalculateAverageCapacityByBrand(b string) (avg float64, err error) {
+	b = internal.NormalizeBrand(b)
 	if b == "" {
 		err = internal.ErrServiceInvalidVehicleBrand
 		return
@@ -388,7 +583,7 @@ func (sv *Default) CalculateAverageCapacityByBrand(b string) (avg float64, err e
 	vehiclesBrand := make([]internal.Vehicle, 0)
 	passengersSum := 0
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Brand == b {
+		if strings.EqualFold(vehicle.Attributes.Brand, b) {
 			vehiclesBrand = append(vehiclesBrand, vehicle)
 			passengersSum += vehicle.Attributes.Passengers
 		}
@@ -403,12 +598,12 @@ func (sv *Default) CalculateAverageCapacityByBrand(b string) (avg float64, err e
 	return
 }
 
-func (sv *Default) FindAllByDimensions(minH, maxH, minW, maxW float64) (v []internal.Vehicle, err error) {
-	if minH < 1 || maxH < minH {
+func (sv *Default) FindAllByDimensions(height, width internal.Interval) (v []internal.Vehicle, err error) {
+	if !validInterval(height, 0) {
 		err = internal.ErrServiceInvalidVehicleHeight
 		return
 	}
-	if minW < 1 || maxW < minW {
+	if !validInterval(width, 0) {
 		err = internal.ErrServiceInvalidVehicleWidth
 		return
 	}
@@ -425,7 +620,7 @@ func (sv *Default) FindAllByDimensions(minH, maxH, minW, maxW float64) (v []inte
 
 	vehiclesWithDimensions := make([]internal.Vehicle, 0)
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Height > minH && vehicle.Attributes.Height < maxH && vehicle.Attributes.Width > minW && vehicle.Attributes.Width < maxW {
+		if height.Contains(vehicle.Attributes.Height) && width.Contains(vehicle.Attributes.Width) {
 			vehiclesWithDimensions = append(vehiclesWithDimensions, vehicle)
 		}
 	}
@@ -437,8 +632,8 @@ func (sv *Default) FindAllByDimensions(minH, maxH, minW, maxW float64) (v []inte
 	return vehiclesWithDimensions, nil
 }
 
-func (sv *Default) FindAllByWeight(minW, maxW float64) (v []internal.Vehicle, err error) {
-	if minW < 1 || maxW < minW {
+func (sv *Default) FindAllByWeight(weight internal.Interval) (v []internal.Vehicle, err error) {
+	if !validInterval(weight, 0) {
 		err = internal.ErrServiceInvalidVehicleWeight
 		return
 	}
@@ -455,7 +650,7 @@ func (sv *Default) FindAllByWeight(minW, maxW float64) (v []internal.Vehicle, er
 
 	vehiclesWithWeight := make([]internal.Vehicle, 0)
 	for _, vehicle := range vehicles {
-		if vehicle.Attributes.Weight > minW && vehicle.Attributes.Weight < maxW {
+		if weight.Contains(vehicle.Attributes.Weight) {
 			vehiclesWithWeight = append(vehiclesWithWeight, vehicle)
 		}
 	}
@@ -466,3 +661,84 @@ func (sv *Default) FindAllByWeight(minW, maxW float64) (v []internal.Vehicle, er
 
 	return vehiclesWithWeight, nil
 }
+
+func (sv *Default) FindAllByChargingConnector(c string) (v []internal.Vehicle, err error) {
+	c = internal.NormalizeChargingConnector(c)
+	if !sv.canonical(internal.ReferenceChargingConnectors, &c) {
+		err = internal.ErrServiceInvalidVehicleChargingConnector
+		return
+	}
+
+	vehicles, err := sv.FindAll()
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrRepositoryVehiclesNotFound):
+			return nil, internal.ErrServiceVehiclesNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	vehiclesByChargingConnector := make([]internal.Vehicle, 0)
+	for _, vehicle := range vehicles {
+		if strings.EqualFold(vehicle.Attributes.ChargingConnector, c) {
+			vehiclesByChargingConnector = append(vehiclesByChargingConnector, vehicle)
+		}
+	}
+
+	if len(vehiclesByChargingConnector) == 0 {
+		return nil, internal.ErrServiceVehiclesNotFound
+	}
+
+	return vehiclesByChargingConnector, nil
+}
+
+func (sv *Default) FindAllByRange(r internal.Interval) (v []internal.Vehicle, err error) {
+	if !validInterval(r, 0) {
+		err = internal.ErrServiceInvalidVehicleRange
+		return
+	}
+
+	vehicles, err := sv.FindAll()
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrRepositoryVehiclesNotFound):
+			return nil, internal.ErrServiceVehiclesNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	// only electric and hybrid vehicles have a range
+	vehiclesWithRange := make([]internal.Vehicle, 0)
+	for _, vehicle := range vehicles {
+		if vehicle.Attributes.BatteryCapacity > 0 && r.Contains(float64(vehicle.Attributes.Range)) {
+			vehiclesWithRange = append(vehiclesWithRange, vehicle)
+		}
+	}
+
+	if len(vehiclesWithRange) == 0 {
+		return nil, internal.ErrServiceVehiclesNotFound
+	}
+
+	return vehiclesWithRange, nil
+}
+
+// validInterval returns true if the interval is valid and none of its bounds is below min.
+func validInterval(in internal.Interval, min float64) bool {
+	return in.Valid() && (in.Min == nil || in.Min.Value >= min) && (in.Max == nil || in.Max.Value >= min)
+}
+
+// ForEach calls fn with each vehicle as it is read from the repository, until fn returns an error.
+func (sv *Default) ForEach(fn func(v internal.Vehicle) (err error)) (err error) {
+	err = sv.rp.ForEach(fn)
+	if err != nil {
+		if errors.Is(err, internal.ErrRepositoryVehiclesNotFound) {
+			err = fmt.Errorf("%w. %v", internal.ErrServiceVehiclesNotFound, err)
+			return
+		}
+		return
+	}
+
+	return
+}
\ No newline at end of file
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/eventbus"
+	"app/internal/repository"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newTestDefault returns a vehicle service with a gasoline and an electric vehicle, and the events it publishes.
+func newTestDefault(t *testing.T) (sv *Default, events <-chan internal.VehicleEvent) {
+	vehicles := []internal.Vehicle{
+		{ID: 1, Attributes: internal.VehicleAttributes{
+			Brand: "Ford", Model: "Focus", Registration: "AAA111", Year: 2015, Color: "red", MaxSpeed: 200,
+			FuelType: "gasoline", Transmission: "manual", Passengers: 5, Height: 148, Width: 182, Weight: 1300,
+		}},
+		{ID: 2, Attributes: internal.VehicleAttributes{
+			Brand: "Nissan", Model: "Leaf", Registration: "CCC333", Year: 2020, Color: "white", MaxSpeed: 150,
+			FuelType: "electric", Transmission: "automatic", Passengers: 5, Height: 155, Width: 177, Weight: 1500,
+			BatteryCapacity: 40, Range: 270, ChargingConnector: "CHAdeMO",
+		}},
+	}
+	bus := eventbus.NewVehicleMemory(16)
+	_, events, cancel, err := bus.Subscribe(internal.VehicleEventFilter{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cancel)
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	sv = NewDefault(repository.NewVehicleSlice(vehicles, len(vehicles)), bus, sr, repository.NewOwnerMap())
+	return
+}
+
+// nextChanges returns the changes of the next event published.
+func nextChanges(t *testing.T, events <-chan internal.VehicleEvent) []string {
+	t.Helper()
+	select {
+	case e := <-events:
+		return e.Changes
+	default:
+		t.Fatal("no events published")
+		return nil
+	}
+}
+
+func TestDefault_UpdateFuelTypeById(t *testing.T) {
+	t.Run("to electric with the energy attributes", func(t *testing.T) {
+		sv, events := newTestDefault(t)
+
+		uv, err := sv.UpdateFuelTypeById(1, internal.VehicleFuel{FuelType: "EV", BatteryCapacity: 60, Range: 400, ChargingConnector: "CCS2"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := uv.Attributes
+		if a.FuelType != "electric" || a.BatteryCapacity != 60 || a.Range != 400 || a.ChargingConnector != "CCS2" {
+			t.Errorf("attributes = %+v", a)
+		}
+		// - the other attributes are kept
+		if a.Brand != "Ford" || a.MaxSpeed != 200 {
+			t.Errorf("attributes = %+v", a)
+		}
+		if got, _ := sv.FindAllByChargingConnector("CCS2"); len(got) != 1 || got[0].ID != 1 {
+			t.Errorf("vehicles by connector = %+v", got)
+		}
+		if got, want := nextChanges(t, events), []string{"fuel_type", "battery_capacity", "range", "charging_connector"}; !slices.Equal(got, want) {
+			t.Errorf("changes = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("from electric clearing the energy attributes", func(t *testing.T) {
+		sv, events := newTestDefault(t)
+
+		uv, err := sv.UpdateFuelTypeById(2, internal.VehicleFuel{FuelType: "diesel"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := uv.Attributes
+		if a.FuelType != "diesel" || a.BatteryCapacity != 0 || a.Range != 0 || a.ChargingConnector != "" {
+			t.Errorf("attributes = %+v", a)
+		}
+		if got, want := nextChanges(t, events), []string{"fuel_type", "battery_capacity", "range", "charging_connector"}; !slices.Equal(got, want) {
+			t.Errorf("changes = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			name string
+			id   int
+			f    internal.VehicleFuel
+			err  error
+		}{
+			{"fuel type", 1, internal.VehicleFuel{FuelType: "coal"}, internal.ErrServiceInvalidVehicleFuelType},
+			{"electric without battery", 1, internal.VehicleFuel{FuelType: "electric", Range: 400, ChargingConnector: "ccs2"}, internal.ErrServiceInvalidVehicleBatteryCapacity},
+			{"electric without range", 1, internal.VehicleFuel{FuelType: "electric", BatteryCapacity: 60, ChargingConnector: "ccs2"}, internal.ErrServiceInvalidVehicleRange},
+			{"electric with unknown connector", 1, internal.VehicleFuel{FuelType: "electric", BatteryCapacity: 60, Range: 400, ChargingConnector: "plug"}, internal.ErrServiceInvalidVehicleChargingConnector},
+			{"gasoline with battery", 2, internal.VehicleFuel{FuelType: "gasoline", BatteryCapacity: 40}, internal.ErrServiceInvalidVehicleBatteryCapacity},
+			{"not found", 99, internal.VehicleFuel{FuelType: "diesel"}, internal.ErrServiceVehicleNotFound},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				sv, events := newTestDefault(t)
+				before, _ := sv.FindAll()
+
+				_, err := sv.UpdateFuelTypeById(c.id, c.f)
+				if !errors.Is(err, c.err) {
+					t.Fatalf("err = %v, want %v", err, c.err)
+				}
+				// - the vehicle is not changed
+				if after, _ := sv.FindAll(); !slices.EqualFunc(before, after, func(a, b internal.Vehicle) bool { return a == b }) {
+					t.Errorf("vehicles = %+v, want %+v", after, before)
+				}
+				if len(events) != 0 {
+					t.Errorf("events = %d, want none", len(events))
+				}
+			})
+		}
+	})
+}
+
+func TestDefault_UpdateFuelTypeByIdWebhooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		f       internal.VehicleFuel
+		changes []string
+		events  []internal.WebhookEventType
+	}{
+		{"same fuel type", 1, internal.VehicleFuel{FuelType: "gasoline"}, nil,
+			[]internal.WebhookEventType{internal.WebhookEventVehicleUpdated}},
+		{"same fuel type written as an alias", 2, internal.VehicleFuel{FuelType: "EV", BatteryCapacity: 40, Range: 270, ChargingConnector: "CHAdeMO"}, nil,
+			[]internal.WebhookEventType{internal.WebhookEventVehicleUpdated}},
+		{"same fuel type with another range", 2, internal.VehicleFuel{FuelType: "electric", BatteryCapacity: 40, Range: 300, ChargingConnector: "CHAdeMO"}, []string{"range"},
+			[]internal.WebhookEventType{internal.WebhookEventVehicleUpdated}},
+		{"another fuel type", 1, internal.VehicleFuel{FuelType: "diesel"}, []string{"fuel_type"},
+			[]internal.WebhookEventType{internal.WebhookEventVehicleUpdated, internal.WebhookEventVehicleFuelTypeChanged}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv, events := newTestDefault(t)
+			rc := newReceiver(t, http.StatusOK)
+			sw, _ := newTestWebhook(t, rc.URL, 1, time.Millisecond)
+
+			if _, err := sv.UpdateFuelTypeById(tt.id, tt.f); err != nil {
+				t.Fatal(err)
+			}
+			var e internal.VehicleEvent
+			select {
+			case e = <-events:
+			default:
+				t.Fatal("no events published")
+			}
+			if !slices.Equal(e.Changes, tt.changes) {
+				t.Errorf("changes = %v, want %v", e.Changes, tt.changes)
+			}
+
+			// - the webhooks are sent the events of the changes only
+			sw.dispatch(e)
+			eventually(t, func() bool { return len(rc.received()) >= len(tt.events) })
+			time.Sleep(20 * time.Millisecond)
+			var got []internal.WebhookEventType
+			for _, r := range rc.received() {
+				got = append(got, internal.WebhookEventType(r.header.Get("X-Webhook-Event")))
+			}
+			slices.Sort(got)
+			want := slices.Clone(tt.events)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("webhook events = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDefault_Normalization(t *testing.T) {
+	sv, _ := newTestDefault(t)
+
+	// a vehicle written with aliases is stored with the canonical values
+	nv, err := sv.Insert(internal.Vehicle{Attributes: internal.VehicleAttributes{
+		Brand: "chevy", Model: "Bolt", Registration: "DDD444", Year: 2020, Color: "grey", MaxSpeed: 150,
+		FuelType: "ev", Transmission: "Auto", Passengers: 5, Height: 160, Width: 176, Weight: 1600,
+		BatteryCapacity: 65, Range: 400, ChargingConnector: "ccs 1",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := nv.Attributes
+	if a.Brand != "Chevrolet" || a.Color != "Gray" || a.FuelType != "electric" || a.Transmission != "automatic" || a.ChargingConnector != "CCS1" {
+		t.Errorf("attributes = %+v", a)
+	}
+
+	// the queries match the vehicles whatever the case and the alias they are written with
+	tests := []struct {
+		name string
+		find func() ([]internal.Vehicle, error)
+		ids  []int
+	}{
+		{"color and year", func() ([]internal.Vehicle, error) { return sv.FindAllByColorAndYear("GRAY", 2020) }, []int{3}},
+		{"an alias of a color", func() ([]internal.Vehicle, error) { return sv.FindAllByColorAndYear("grey", 2020) }, []int{3}},
+		{"a fuel type", func() ([]internal.Vehicle, error) { return sv.FindAllByFuelType("Electric") }, []int{2, 3}},
+		{"an alias of a fuel type", func() ([]internal.Vehicle, error) { return sv.FindAllByFuelType("petrol") }, []int{1}},
+		{"an alias of a transmission", func() ([]internal.Vehicle, error) { return sv.FindAllByTransmission("stick") }, []int{1}},
+		{"an alias of a brand", func() ([]internal.Vehicle, error) {
+			return sv.FindAllByBrandAndBetweenYears("CHEVROLET", internal.ClosedInterval(2000, 2030))
+		}, []int{3}},
+		{"an alias of a charging connector", func() ([]internal.Vehicle, error) { return sv.FindAllByChargingConnector("ccs combo 1") }, []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.find()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]int, len(v))
+			for i, vh := range v {
+				ids[i] = vh.ID
+			}
+			if !slices.Equal(ids, tt.ids) {
+				t.Errorf("ids = %v, want %v", ids, tt.ids)
+			}
+		})
+	}
+
+	avg, err := sv.CalculateAverageSpeedByBrand("Chevy")
+	if err != nil || avg != 150 {
+		t.Errorf("average speed = %v, %v, want 150", avg, err)
+	}
+}
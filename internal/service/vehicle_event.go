@@ -0,0 +1,50 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+)
+
+// listen subscribes to the events of the bus matching the filter, published from now on, and calls handle with
+// each of them until done is closed. If the bus drops the subscription it subscribes again from the last event seen,
+// so the events in between are handled as long as the bus keeps them. When the bus has already discarded some of them,
+// expired is called with the id of the last event seen, after the kept ones are handled.
+func listen(bus internal.EventBusVehicle, f internal.VehicleEventFilter, done <-chan struct{}, handle func(e internal.VehicleEvent), expired func(lastId int64)) {
+	// - the first subscription is made before returning, so no event published afterwards is missed
+	lastId := bus.LastId()
+	replay, ch, cancel, err := bus.Subscribe(f, lastId)
+
+	go func() {
+		for {
+			for _, e := range replay {
+				handle(e)
+			}
+			// - the events discarded cannot be handled, the listener catches up with the state instead
+			if errors.Is(err, internal.ErrEventBusVehicleEventsExpired) {
+				expired(lastId)
+			}
+			if len(replay) > 0 {
+				lastId = replay[len(replay)-1].ID
+			}
+
+		events:
+			for {
+				select {
+				case <-done:
+					cancel()
+					return
+				case e, ok := <-ch:
+					if !ok {
+						break events
+					}
+					handle(e)
+					lastId = e.ID
+				}
+			}
+			cancel()
+
+			// subscribe again from the last event if the bus drops the subscription
+			replay, ch, cancel, err = bus.Subscribe(f, lastId)
+		}
+	}()
+}
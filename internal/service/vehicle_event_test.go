@@ -0,0 +1,97 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/eventbus"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestListen(t *testing.T) {
+	bus := eventbus.NewVehicleMemory(1024)
+	// - the events published before listening are not handled
+	for i := 0; i < 3; i++ {
+		bus.Publish(internal.VehicleEvent{Type: internal.VehicleEventCreated, VehicleID: i})
+	}
+
+	var mu sync.Mutex
+	var ids []int64
+	block := make(chan struct{})
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	listen(bus, internal.VehicleEventFilter{}, done, func(e internal.VehicleEvent) {
+		<-block
+		mu.Lock()
+		defer mu.Unlock()
+		ids = append(ids, e.ID)
+	}, func(lastId int64) {
+		t.Errorf("events after %d expired, want them kept", lastId)
+	})
+
+	// the handler falls behind so the bus drops the subscription, the events in between are replayed
+	const n = 200
+	for i := 0; i < n; i++ {
+		bus.Publish(internal.VehicleEvent{Type: internal.VehicleEventUpdated, VehicleID: 1})
+	}
+	close(block)
+
+	want := make([]int64, n)
+	for i := range want {
+		want[i] = int64(4 + i)
+	}
+	eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(ids) >= n
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+}
+
+func TestListen_Expired(t *testing.T) {
+	// the bus keeps fewer events than the ones published while the handler falls behind
+	bus := eventbus.NewVehicleMemory(16)
+
+	var mu sync.Mutex
+	var ids []int64
+	var expired []int64
+	block := make(chan struct{})
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	listen(bus, internal.VehicleEventFilter{}, done, func(e internal.VehicleEvent) {
+		<-block
+		mu.Lock()
+		defer mu.Unlock()
+		ids = append(ids, e.ID)
+	}, func(lastId int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		expired = append(expired, lastId)
+	})
+
+	const n = 200
+	for i := 0; i < n; i++ {
+		bus.Publish(internal.VehicleEvent{Type: internal.VehicleEventUpdated, VehicleID: 1})
+	}
+	close(block)
+
+	// - the events are handled up to the last one, and the gap is reported once from the last event seen before it
+	eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(ids) > 0 && ids[len(ids)-1] == n
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	if len(expired) != 1 {
+		t.Fatalf("expired = %v, want one gap", expired)
+	}
+	i := slices.Index(ids, expired[0])
+	if i == -1 || i+1 >= len(ids) || ids[i+1] <= expired[0]+1 {
+		t.Errorf("ids = %v, want a gap after %d", ids, expired[0])
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Search returns the vehicles matching any word of a text in the brand, model, color or registration,
+// case-insensitive and with typos, from the most to the least relevant, at most limit of them if limit is greater than 0.
+func (sv *Default) Search(q string, limit int) (s []internal.VehicleSearchResult, err error) {
+	if strings.TrimSpace(q) == "" {
+		err = internal.ErrServiceInvalidSearchQuery
+		return
+	}
+	if limit < 0 {
+		err = internal.ErrServiceInvalidSearchLimit
+		return
+	}
+
+	s, err = sv.rp.Search(q, limit)
+	if err != nil {
+		if errors.Is(err, internal.ErrRepositoryVehiclesNotFound) {
+			err = fmt.Errorf("%w. %v", internal.ErrServiceVehiclesNotFound, err)
+		}
+		return
+	}
+	return
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// vehicleSpreads are the differences between the max and min values of the numeric attributes of the vehicles.
+type vehicleSpreads struct {
+	year, passengers, height, width float64
+}
+
+// vehicleSimilarDistances are the distances between the attributes of two vehicles, from 0 to 1, by name.
+// Numeric attributes are divided by their spread, so every attribute weighs the same before its weight.
+var vehicleSimilarDistances = map[string]func(a, b internal.VehicleAttributes, s vehicleSpreads) float64{
+	"brand": func(a, b internal.VehicleAttributes, s vehicleSpreads) float64 {
+		return categoricalDistance(a.Brand, b.Brand)
+	},
+	"year": func(a, b internal.VehicleAttributes, s vehicleSpreads) float64 {
+		return numericDistance(float64(a.Year), float64(b.Year), s.year)
+	},
+	"passengers": func(a, b internal.VehicleAttributes, s vehicleSpreads) float64 {
+		return numericDistance(float64(a.Passengers), float64(b.Passengers), s.passengers)
+	},
+	"transmission": func(a, b internal.VehicleAttributes, s vehicleSpreads) float64 {
+		return categoricalDistance(a.Transmission, b.Transmission)
+	},
+	"fuel_type": func(a, b internal.VehicleAttributes, s vehicleSpreads) float64 {
+		return categoricalDistance(a.FuelType, b.FuelType)
+	},
+	"dimensions": func(a, b internal.VehicleAttributes, s vehicleSpreads) float64 {
+		return (numericDistance(a.Height, b.Height, s.height) + numericDistance(a.Width, b.Width, s.width)) / 2
+	},
+}
+
+// categoricalDistance returns 0 if the values are equal and 1 if not.
+func categoricalDistance(a, b string) float64 {
+	if strings.EqualFold(a, b) {
+		return 0
+	}
+	return 1
+}
+
+// numericDistance returns the difference of the values divided by the spread of their attribute.
+func numericDistance(a, b, spread float64) float64 {
+	if spread == 0 {
+		return 0
+	}
+	return math.Abs(a-b) / spread
+}
+
+// similarWeights returns the default weights replaced by the ones of the query.
+func similarWeights(q internal.VehicleSimilarQuery) (w map[string]float64, err error) {
+	w = internal.DefaultVehicleSimilarWeights()
+	for name, weight := range q.Weights {
+		if _, ok := vehicleSimilarDistances[name]; !ok {
+			err = fmt.Errorf("%w: %s is not an attribute", internal.ErrServiceInvalidSimilarWeights, name)
+			return
+		}
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			err = fmt.Errorf("%w: the weight of %s must be a non-negative number", internal.ErrServiceInvalidSimilarWeights, name)
+			return
+		}
+		w[name] = weight
+	}
+
+	var total float64
+	for _, weight := range w {
+		total += weight
+	}
+	if total == 0 {
+		err = fmt.Errorf("%w: all of them are 0", internal.ErrServiceInvalidSimilarWeights)
+	}
+	return
+}
+
+func (sv *Default) FindAllSimilar(q internal.VehicleSimilarQuery) (s []internal.VehicleSimilarResult, err error) {
+	weights, err := similarWeights(q)
+	if err != nil {
+		return
+	}
+	if q.Limit < 0 {
+		err = internal.ErrServiceInvalidSimilarLimit
+		return
+	}
+
+	// the spreads are needed before any distance, so the vehicles are kept while they are read
+	var (
+		vehicles []internal.Vehicle
+		target   *internal.VehicleAttributes
+		n        int
+		min, max vehicleSpreads
+	)
+	err = sv.rp.ForEach(func(v internal.Vehicle) (err error) {
+		a := v.Attributes
+		values := vehicleSpreads{year: float64(a.Year), passengers: float64(a.Passengers), height: a.Height, width: a.Width}
+		if n++; n == 1 {
+			min, max = values, values
+		}
+		min.year, max.year = math.Min(min.year, values.year), math.Max(max.year, values.year)
+		min.passengers, max.passengers = math.Min(min.passengers, values.passengers), math.Max(max.passengers, values.passengers)
+		min.height, max.height = math.Min(min.height, values.height), math.Max(max.height, values.height)
+		min.width, max.width = math.Min(min.width, values.width), math.Max(max.width, values.width)
+
+		if v.ID == q.ID {
+			target = &a
+			return
+		}
+		vehicles = append(vehicles, v)
+		return
+	})
+	if err != nil {
+		if errors.Is(err, internal.ErrRepositoryVehiclesNotFound) {
+			err = fmt.Errorf("%w. %v", internal.ErrServiceVehicleNotFound, err)
+		}
+		return
+	}
+	if target == nil {
+		err = internal.ErrServiceVehicleNotFound
+		return
+	}
+	if len(vehicles) == 0 {
+		err = internal.ErrServiceVehiclesNotFound
+		return
+	}
+	spreads := vehicleSpreads{
+		year:       max.year - min.year,
+		passengers: max.passengers - min.passengers,
+		height:     max.height - min.height,
+		width:      max.width - min.width,
+	}
+
+	// - the distances are summed in the same order for every vehicle, so equal ones are not told apart by rounding
+	names := make([]string, 0, len(weights))
+	var total float64
+	for name, weight := range weights {
+		names = append(names, name)
+		total += weight
+	}
+	sort.Strings(names)
+	s = make([]internal.VehicleSimilarResult, len(vehicles))
+	for i, v := range vehicles {
+		var d float64
+		for _, name := range names {
+			d += weights[name] * vehicleSimilarDistances[name](*target, v.Attributes, spreads)
+		}
+		s[i] = internal.VehicleSimilarResult{Vehicle: v, Distance: d / total}
+	}
+
+	// ties are in the order of the identifiers, so the results do not change between requests
+	sort.Slice(s, func(i, j int) bool {
+		if s[i].Distance != s[j].Distance {
+			return s[i].Distance < s[j].Distance
+		}
+		return s[i].Vehicle.ID < s[j].Vehicle.ID
+	})
+	if q.Limit > 0 && len(s) > q.Limit {
+		s = s[:q.Limit]
+	}
+	return
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"app/internal"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// vehicleCategoricalAttributes are the attributes vehicles can be grouped by, by name.
+var vehicleCategoricalAttributes = map[string]func(a internal.VehicleAttributes) string{
+	"brand":              func(a internal.VehicleAttributes) string { return a.Brand },
+	"model":              func(a internal.VehicleAttributes) string { return a.Model },
+	"color":              func(a internal.VehicleAttributes) string { return a.Color },
+	"fuel_type":          func(a internal.VehicleAttributes) string { return a.FuelType },
+	"transmission":       func(a internal.VehicleAttributes) string { return a.Transmission },
+	"charging_connector": func(a internal.VehicleAttributes) string { return a.ChargingConnector },
+	"year":               func(a internal.VehicleAttributes) string { return strconv.Itoa(a.Year) },
+	"passengers":         func(a internal.VehicleAttributes) string { return strconv.Itoa(a.Passengers) },
+}
+
+// vehicleCategoricalNormalizers are the normalizations of the categorical attributes with canonical values, by name.
+var vehicleCategoricalNormalizers = map[string]func(s string) string{
+	"brand":              internal.NormalizeBrand,
+	"color":              internal.NormalizeColor,
+	"fuel_type":          internal.NormalizeFuelType,
+	"transmission":       internal.NormalizeTransmission,
+	"charging_connector": internal.NormalizeChargingConnector,
+}
+
+// vehicleNumericAttributes are the attributes metrics can be calculated of, by name.
+var vehicleNumericAttributes = map[string]func(a internal.VehicleAttributes) float64{
+	"year":             func(a internal.VehicleAttributes) float64 { return float64(a.Year) },
+	"max_speed":        func(a internal.VehicleAttributes) float64 { return float64(a.MaxSpeed) },
+	"passengers":       func(a internal.VehicleAttributes) float64 { return float64(a.Passengers) },
+	"height":           func(a internal.VehicleAttributes) float64 { return a.Height },
+	"width":            func(a internal.VehicleAttributes) float64 { return a.Width },
+	"weight":           func(a internal.VehicleAttributes) float64 { return a.Weight },
+	"battery_capacity": func(a internal.VehicleAttributes) float64 { return a.BatteryCapacity },
+	"range":            func(a internal.VehicleAttributes) float64 { return float64(a.Range) },
+}
+
+// statsAccumulator is an struct that accumulates the values of a numeric attribute of a group.
+// Mean and variance are updated incrementally (Welford), values are only kept for medians and percentiles.
+type statsAccumulator struct {
+	n        int
+	min, max float64
+	sum      float64
+	mean, m2 float64
+	values   []float64
+	// keep is whether values are kept.
+	keep bool
+}
+
+// add accumulates a value.
+func (a *statsAccumulator) add(x float64) {
+	a.n++
+	if a.n == 1 || x < a.min {
+		a.min = x
+	}
+	if a.n == 1 || x > a.max {
+		a.max = x
+	}
+	a.sum += x
+	delta := x - a.mean
+	a.mean += delta / float64(a.n)
+	a.m2 += delta * (x - a.mean)
+	if a.keep {
+		a.values = append(a.values, x)
+	}
+}
+
+// value returns the aggregate of the accumulated values, the values have to be sorted for medians and percentiles.
+func (a *statsAccumulator) value(aggregate string) float64 {
+	switch aggregate {
+	case "sum":
+		return a.sum
+	case "min":
+		return a.min
+	case "max":
+		return a.max
+	case "avg", "mean":
+		return a.mean
+	case "stddev":
+		// population standard deviation
+		return math.Sqrt(a.m2 / float64(a.n))
+	case "median":
+		return percentile(a.values, 50)
+	}
+	p, _ := parsePercentile(aggregate)
+	return percentile(a.values, p)
+}
+
+// percentile returns the p-th percentile of the sorted values, interpolating linearly between the closest ranks.
+func percentile(sorted []float64, p int) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := float64(p) / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
+
+// parsePercentile returns the percentile of an aggregate named pN (e.g. p95), N from 1 to 99.
+func parsePercentile(aggregate string) (p int, ok bool) {
+	if !strings.HasPrefix(aggregate, "p") {
+		return
+	}
+	p, err := strconv.Atoi(aggregate[1:])
+	if err != nil || p < 1 || p > 99 {
+		return
+	}
+	return p, true
+}
+
+// validateStatsQuery returns an error if the query has unknown attributes or aggregates.
+func validateStatsQuery(q internal.VehicleStatsQuery) (err error) {
+	seen := make(map[string]bool)
+	for _, g := range q.GroupBy {
+		if _, ok := vehicleCategoricalAttributes[g]; !ok || seen[g] {
+			return fmt.Errorf("%w: %q", internal.ErrServiceInvalidStatsGroupBy, g)
+		}
+		seen[g] = true
+	}
+
+	for _, m := range q.Metrics {
+		if m.Aggregate == "count" && m.Attribute == "" {
+			continue
+		}
+		if _, ok := vehicleNumericAttributes[m.Attribute]; !ok {
+			return fmt.Errorf("%w: unknown attribute %q", internal.ErrServiceInvalidStatsMetric, m.Attribute)
+		}
+		switch m.Aggregate {
+		case "count", "sum", "min", "max", "avg", "mean", "median", "stddev":
+			continue
+		}
+		if _, ok := parsePercentile(m.Aggregate); !ok {
+			return fmt.Errorf("%w: unknown aggregate %q", internal.ErrServiceInvalidStatsMetric, m.Aggregate)
+		}
+	}
+	return
+}
+
+// CalculateStats returns the metrics of each group of vehicles, sorted by the values of the group.
+// All the metrics are computed in a single pass over the vehicles.
+func (sv *Default) CalculateStats(q internal.VehicleStatsQuery) (s []internal.VehicleStats, err error) {
+	if err = validateStatsQuery(q); err != nil {
+		return
+	}
+
+	// attributes whose values are accumulated, and whether they need to be kept
+	keep := make(map[string]bool)
+	for _, m := range q.Metrics {
+		if m.Attribute == "" {
+			continue
+		}
+		_, isPercentile := parsePercentile(m.Aggregate)
+		keep[m.Attribute] = keep[m.Attribute] || m.Aggregate == "median" || isPercentile
+	}
+
+	// accumulate
+	type group struct {
+		values []string
+		count  int
+		acc    map[string]*statsAccumulator
+	}
+	groups := make(map[string]*group)
+	err = sv.rp.ForEach(func(v internal.Vehicle) (err error) {
+		values := make([]string, len(q.GroupBy))
+		for i, g := range q.GroupBy {
+			values[i] = vehicleCategoricalAttributes[g](v.Attributes)
+		}
+		key := strings.Join(values, "\x00")
+
+		gr, ok := groups[key]
+		if !ok {
+			gr = &group{values: values, acc: make(map[string]*statsAccumulator)}
+			for attr, k := range keep {
+				gr.acc[attr] = &statsAccumulator{keep: k}
+			}
+			groups[key] = gr
+		}
+		gr.count++
+		for attr, acc := range gr.acc {
+			acc.add(vehicleNumericAttributes[attr](v.Attributes))
+		}
+		return
+	})
+	if err != nil {
+		if errors.Is(err, internal.ErrRepositoryVehiclesNotFound) {
+			err = fmt.Errorf("%w. %v", internal.ErrServiceVehiclesNotFound, err)
+		}
+		return
+	}
+	if len(groups) == 0 {
+		err = internal.ErrServiceVehiclesNotFound
+		return
+	}
+
+	// metrics
+	s = make([]internal.VehicleStats, 0, len(groups))
+	for _, gr := range groups {
+		for _, acc := range gr.acc {
+			sort.Float64s(acc.values)
+		}
+		st := internal.VehicleStats{Group: gr.values, Count: gr.count, Values: make([]float64, len(q.Metrics))}
+		for i, m := range q.Metrics {
+			if m.Aggregate == "count" {
+				st.Values[i] = float64(gr.count)
+				continue
+			}
+			st.Values[i] = gr.acc[m.Attribute].value(m.Aggregate)
+		}
+		s = append(s, st)
+	}
+	sort.Slice(s, func(i, j int) bool {
+		for k := range s[i].Group {
+			if s[i].Group[k] != s[j].Group[k] {
+				return s[i].Group[k] < s[j].Group[k]
+			}
+		}
+		return false
+	})
+	return
+}
+
+// maxHistogramBuckets is the max number of buckets of a histogram.
+const maxHistogramBuckets = 1000
+
+// CalculateHistogram returns the distribution of a numeric attribute in each group of the vehicles matching the filter,
+// sorted by the values of the group. Without edges, the buckets split the range of values of all the groups.
+func (sv *Default) CalculateHistogram(q internal.VehicleHistogramQuery) (h []internal.VehicleHistogram, err error) {
+	value, ok := vehicleNumericAttributes[q.Attribute]
+	if !ok {
+		err = fmt.Errorf("%w: %q", internal.ErrServiceInvalidHistogramAttribute, q.Attribute)
+		return
+	}
+	if len(q.Edges) > 0 {
+		if len(q.Edges) < 2 || len(q.Edges) > maxHistogramBuckets+1 {
+			err = fmt.Errorf("%w: there must be from 2 to %d edges", internal.ErrServiceInvalidHistogramBuckets, maxHistogramBuckets+1)
+			return
+		}
+		for i := 1; i < len(q.Edges); i++ {
+			if q.Edges[i] <= q.Edges[i-1] {
+				err = fmt.Errorf("%w: edges must be ascending", internal.ErrServiceInvalidHistogramBuckets)
+				return
+			}
+		}
+	} else if q.Buckets < 1 || q.Buckets > maxHistogramBuckets {
+		err = fmt.Errorf("%w: there must be from 1 to %d buckets", internal.ErrServiceInvalidHistogramBuckets, maxHistogramBuckets)
+		return
+	}
+	filter := make(map[string]string, len(q.Filter))
+	for attr, want := range q.Filter {
+		if _, ok := vehicleCategoricalAttributes[attr]; !ok {
+			err = fmt.Errorf("%w: %q", internal.ErrServiceInvalidHistogramFilter, attr)
+			return
+		}
+		if normalize, ok := vehicleCategoricalNormalizers[attr]; ok {
+			want = normalize(want)
+		}
+		filter[attr] = want
+	}
+	seen := make(map[string]bool)
+	for _, g := range q.GroupBy {
+		if _, ok := vehicleCategoricalAttributes[g]; !ok || seen[g] {
+			err = fmt.Errorf("%w: %q", internal.ErrServiceInvalidHistogramGroupBy, g)
+			return
+		}
+		seen[g] = true
+	}
+
+	vehicles, err := sv.FindAll()
+	if err != nil {
+		return
+	}
+
+	// values of the vehicles matching the filter, by group
+	type group struct {
+		values []string
+		data   []float64
+	}
+	groups := make(map[string]*group)
+	n := 0
+	min, max := 0.0, 0.0
+	for _, v := range vehicles {
+		matches := true
+		for attr, want := range filter {
+			if !strings.EqualFold(vehicleCategoricalAttributes[attr](v.Attributes), want) {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+
+		values := make([]string, len(q.GroupBy))
+		for i, g := range q.GroupBy {
+			values[i] = vehicleCategoricalAttributes[g](v.Attributes)
+		}
+		key := strings.Join(values, "\x00")
+		gr, ok := groups[key]
+		if !ok {
+			gr = &group{values: values}
+			groups[key] = gr
+		}
+
+		x := value(v.Attributes)
+		gr.data = append(gr.data, x)
+		if n == 0 || x < min {
+			min = x
+		}
+		if n == 0 || x > max {
+			max = x
+		}
+		n++
+	}
+	if n == 0 {
+		err = internal.ErrServiceVehiclesNotFound
+		return
+	}
+
+	// edges
+	edges := q.Edges
+	if len(edges) == 0 {
+		buckets := q.Buckets
+		// all the values are in a single bucket if they are the same
+		if min == max {
+			buckets = 1
+		}
+		edges = make([]float64, buckets+1)
+		width := (max - min) / float64(buckets)
+		for i := range edges {
+			edges[i] = min + width*float64(i)
+		}
+		edges[buckets] = max
+	}
+
+	// buckets
+	h = make([]internal.VehicleHistogram, 0, len(groups))
+	for _, gr := range groups {
+		hg := internal.VehicleHistogram{Group: gr.values, Buckets: make([]internal.VehicleHistogramBucket, len(edges)-1)}
+		for i := range hg.Buckets {
+			hg.Buckets[i].Min = edges[i]
+			hg.Buckets[i].Max = edges[i+1]
+		}
+		for _, x := range gr.data {
+			if x < edges[0] || x > edges[len(edges)-1] {
+				continue
+			}
+			// first edge greater than x, the last bucket includes its max
+			i := sort.SearchFloat64s(edges, x)
+			if i < len(edges) && edges[i] == x {
+				i++
+			}
+			if i > len(hg.Buckets) {
+				i = len(hg.Buckets)
+			}
+			hg.Buckets[i-1].Count++
+		}
+		h = append(h, hg)
+	}
+	sort.Slice(h, func(i, j int) bool {
+		for k := range h[i].Group {
+			if h[i].Group[k] != h[j].Group[k] {
+				return h[i].Group[k] < h[j].Group[k]
+			}
+		}
+		return false
+	})
+	return
+}
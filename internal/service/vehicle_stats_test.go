@@ -0,0 +1,189 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/repository"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// newTestStats returns a vehicle service over three Ford vehicles and two Nissan ones,
+// with max speeds 140, 150, 180, 200 and 250, or over the vehicles if they are not nil.
+func newTestStats(vehicles []internal.Vehicle) *Default {
+	if vehicles == nil {
+		vehicles = []internal.Vehicle{
+			{ID: 1, Attributes: internal.VehicleAttributes{Brand: "Ford", FuelType: "gasoline", MaxSpeed: 200, Passengers: 5, Weight: 1300}},
+			{ID: 2, Attributes: internal.VehicleAttributes{Brand: "Ford", FuelType: "gasoline", MaxSpeed: 250, Passengers: 5, Weight: 1700}},
+			{ID: 3, Attributes: internal.VehicleAttributes{Brand: "Ford", FuelType: "electric", MaxSpeed: 180, Passengers: 5, Weight: 2000}},
+			{ID: 4, Attributes: internal.VehicleAttributes{Brand: "Nissan", FuelType: "electric", MaxSpeed: 150, Passengers: 5, Weight: 1500}},
+			{ID: 5, Attributes: internal.VehicleAttributes{Brand: "Nissan", FuelType: "electric", MaxSpeed: 140, Passengers: 5, Weight: 1600}},
+		}
+	}
+	sr := NewReferenceDefault(repository.NewReferenceMap(internal.DefaultReferences()))
+	return NewDefault(repository.NewVehicleSlice(vehicles, len(vehicles)), nil, sr, repository.NewOwnerMap())
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []float64
+		p      int
+		want   float64
+	}{
+		{"without values", nil, 50, 0},
+		{"a single value", []float64{7}, 90, 7},
+		{"a rank", []float64{1, 2, 3, 4, 5}, 25, 2},
+		{"between ranks", []float64{1, 2, 3, 4}, 50, 2.5},
+		{"the lowest", []float64{1, 2, 3, 4, 5}, 1, 1.04},
+		{"the highest", []float64{1, 2, 3, 4, 5}, 99, 4.96},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(tt.sorted, tt.p); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("percentile = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefault_CalculateStats(t *testing.T) {
+	metric := func(aggregate, attribute string) internal.VehicleStatsMetric {
+		return internal.VehicleStatsMetric{Aggregate: aggregate, Attribute: attribute}
+	}
+
+	tests := []struct {
+		name  string
+		q     internal.VehicleStatsQuery
+		stats []internal.VehicleStats
+		err   error
+	}{
+		{"aggregates", internal.VehicleStatsQuery{Metrics: []internal.VehicleStatsMetric{
+			metric("count", ""), metric("sum", "max_speed"), metric("min", "max_speed"), metric("max", "max_speed"),
+			metric("avg", "max_speed"), metric("mean", "max_speed"), metric("stddev", "max_speed"),
+		}}, []internal.VehicleStats{
+			{Group: []string{}, Count: 5, Values: []float64{5, 920, 140, 250, 184, 184, math.Sqrt(1544)}},
+		}, nil},
+		{"medians and percentiles", internal.VehicleStatsQuery{Metrics: []internal.VehicleStatsMetric{
+			metric("median", "max_speed"), metric("p25", "max_speed"), metric("p90", "max_speed"), metric("p99", "max_speed"),
+			metric("median", "weight"),
+		}}, []internal.VehicleStats{
+			{Group: []string{}, Count: 5, Values: []float64{180, 150, 230, 248, 1600}},
+		}, nil},
+		{"by a group", internal.VehicleStatsQuery{GroupBy: []string{"brand"}, Metrics: []internal.VehicleStatsMetric{
+			metric("count", ""), metric("avg", "max_speed"), metric("median", "max_speed"),
+		}}, []internal.VehicleStats{
+			{Group: []string{"Ford"}, Count: 3, Values: []float64{3, 210, 200}},
+			{Group: []string{"Nissan"}, Count: 2, Values: []float64{2, 145, 145}},
+		}, nil},
+		{"by groups", internal.VehicleStatsQuery{GroupBy: []string{"brand", "fuel_type"}, Metrics: []internal.VehicleStatsMetric{
+			metric("max", "weight"),
+		}}, []internal.VehicleStats{
+			{Group: []string{"Ford", "electric"}, Count: 1, Values: []float64{2000}},
+			{Group: []string{"Ford", "gasoline"}, Count: 2, Values: []float64{1700}},
+			{Group: []string{"Nissan", "electric"}, Count: 2, Values: []float64{1600}},
+		}, nil},
+		{"without metrics", internal.VehicleStatsQuery{GroupBy: []string{"fuel_type"}}, []internal.VehicleStats{
+			{Group: []string{"electric"}, Count: 3, Values: []float64{}},
+			{Group: []string{"gasoline"}, Count: 2, Values: []float64{}},
+		}, nil},
+
+		{"an unknown group", internal.VehicleStatsQuery{GroupBy: []string{"owner"}}, nil, internal.ErrServiceInvalidStatsGroupBy},
+		{"a repeated group", internal.VehicleStatsQuery{GroupBy: []string{"brand", "brand"}}, nil, internal.ErrServiceInvalidStatsGroupBy},
+		{"an unknown attribute", internal.VehicleStatsQuery{Metrics: []internal.VehicleStatsMetric{metric("avg", "brand")}},
+			nil, internal.ErrServiceInvalidStatsMetric},
+		{"an unknown aggregate", internal.VehicleStatsQuery{Metrics: []internal.VehicleStatsMetric{metric("mode", "max_speed")}},
+			nil, internal.ErrServiceInvalidStatsMetric},
+		{"a percentile out of range", internal.VehicleStatsQuery{Metrics: []internal.VehicleStatsMetric{metric("p100", "max_speed")}},
+			nil, internal.ErrServiceInvalidStatsMetric},
+		{"an aggregate without attribute", internal.VehicleStatsQuery{Metrics: []internal.VehicleStatsMetric{metric("avg", "")}},
+			nil, internal.ErrServiceInvalidStatsMetric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats, err := newTestStats(nil).CalculateStats(tt.q)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(stats) != len(tt.stats) {
+				t.Fatalf("stats = %+v, want %+v", stats, tt.stats)
+			}
+			for i, want := range tt.stats {
+				st := stats[i]
+				if !slices.Equal(st.Group, want.Group) || st.Count != want.Count || len(st.Values) != len(want.Values) {
+					t.Fatalf("stats[%d] = %+v, want %+v", i, st, want)
+				}
+				for j := range want.Values {
+					if math.Abs(st.Values[j]-want.Values[j]) > 1e-9 {
+						t.Errorf("stats[%d] %s = %v, want %v", i, tt.q.Metrics[j].Name(), st.Values[j], want.Values[j])
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("without vehicles", func(t *testing.T) {
+		_, err := newTestStats([]internal.Vehicle{}).CalculateStats(internal.VehicleStatsQuery{})
+		if !errors.Is(err, internal.ErrServiceVehiclesNotFound) {
+			t.Errorf("err = %v, want %v", err, internal.ErrServiceVehiclesNotFound)
+		}
+	})
+}
+
+func TestDefault_CalculateHistogram(t *testing.T) {
+	type bucket = internal.VehicleHistogramBucket
+
+	// the weights are 1300, 1500, 1600, 1700 and 2000
+	tests := []struct {
+		name string
+		q    internal.VehicleHistogramQuery
+		h    []internal.VehicleHistogram
+		err  error
+	}{
+		{"buckets of the same width", internal.VehicleHistogramQuery{Attribute: "weight", Buckets: 2}, []internal.VehicleHistogram{
+			{Group: []string{}, Buckets: []bucket{{Min: 1300, Max: 1650, Count: 3}, {Min: 1650, Max: 2000, Count: 2}}},
+		}, nil},
+		{"the last bucket includes its max", internal.VehicleHistogramQuery{Attribute: "weight", Edges: []float64{1000, 1500, 2000}}, []internal.VehicleHistogram{
+			{Group: []string{}, Buckets: []bucket{{Min: 1000, Max: 1500, Count: 1}, {Min: 1500, Max: 2000, Count: 4}}},
+		}, nil},
+		{"values out of the edges", internal.VehicleHistogramQuery{Attribute: "weight", Edges: []float64{1400, 1600, 1800}}, []internal.VehicleHistogram{
+			{Group: []string{}, Buckets: []bucket{{Min: 1400, Max: 1600, Count: 1}, {Min: 1600, Max: 1800, Count: 2}}},
+		}, nil},
+		{"a single value", internal.VehicleHistogramQuery{Attribute: "passengers", Buckets: 3}, []internal.VehicleHistogram{
+			{Group: []string{}, Buckets: []bucket{{Min: 5, Max: 5, Count: 5}}},
+		}, nil},
+		{"by a group, with the buckets of all of them", internal.VehicleHistogramQuery{Attribute: "weight", Buckets: 2, GroupBy: []string{"fuel_type"}}, []internal.VehicleHistogram{
+			{Group: []string{"electric"}, Buckets: []bucket{{Min: 1300, Max: 1650, Count: 2}, {Min: 1650, Max: 2000, Count: 1}}},
+			{Group: []string{"gasoline"}, Buckets: []bucket{{Min: 1300, Max: 1650, Count: 1}, {Min: 1650, Max: 2000, Count: 1}}},
+		}, nil},
+		{"filtered by a canonical value", internal.VehicleHistogramQuery{Attribute: "max_speed", Buckets: 1, Filter: map[string]string{"brand": "FORD", "fuel_type": "gas"}}, []internal.VehicleHistogram{
+			{Group: []string{}, Buckets: []bucket{{Min: 200, Max: 250, Count: 2}}},
+		}, nil},
+
+		{"filtered out", internal.VehicleHistogramQuery{Attribute: "weight", Buckets: 1, Filter: map[string]string{"brand": "Tesla"}}, nil, internal.ErrServiceVehiclesNotFound},
+		{"a categorical attribute", internal.VehicleHistogramQuery{Attribute: "brand", Buckets: 1}, nil, internal.ErrServiceInvalidHistogramAttribute},
+		{"without buckets", internal.VehicleHistogramQuery{Attribute: "weight"}, nil, internal.ErrServiceInvalidHistogramBuckets},
+		{"too many buckets", internal.VehicleHistogramQuery{Attribute: "weight", Buckets: maxHistogramBuckets + 1}, nil, internal.ErrServiceInvalidHistogramBuckets},
+		{"a single edge", internal.VehicleHistogramQuery{Attribute: "weight", Edges: []float64{1000}}, nil, internal.ErrServiceInvalidHistogramBuckets},
+		{"edges not ascending", internal.VehicleHistogramQuery{Attribute: "weight", Edges: []float64{1000, 1000, 2000}}, nil, internal.ErrServiceInvalidHistogramBuckets},
+		{"an unknown filter", internal.VehicleHistogramQuery{Attribute: "weight", Buckets: 1, Filter: map[string]string{"owner": "1"}}, nil, internal.ErrServiceInvalidHistogramFilter},
+		{"a repeated group", internal.VehicleHistogramQuery{Attribute: "weight", Buckets: 1, GroupBy: []string{"brand", "brand"}}, nil, internal.ErrServiceInvalidHistogramGroupBy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := newTestStats(nil).CalculateHistogram(tt.q)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(h) != len(tt.h) {
+				t.Fatalf("histogram = %+v, want %+v", h, tt.h)
+			}
+			for i, want := range tt.h {
+				if !slices.Equal(h[i].Group, want.Group) || !slices.Equal(h[i].Buckets, want.Buckets) {
+					t.Errorf("histogram[%d] = %+v, want %+v", i, h[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"app/internal"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ConfigWebhookDefault is an struct that contains the configuration of the webhook service.
+type ConfigWebhookDefault struct {
+	// Client is the http client the events are sent with.
+	Client *http.Client
+	// MaxAttempts is the number of attempts before an event goes to the dead letters.
+	MaxAttempts int
+	// Backoff is the wait after the first failed attempt, doubled after each one.
+	Backoff time.Duration
+}
+
+// NewWebhookDefault returns a new instance of a webhook service.
+func NewWebhookDefault(rp internal.RepositoryWebhook, c *ConfigWebhookDefault) *WebhookDefault {
+	// default config
+	defaultCfg := &ConfigWebhookDefault{
+		Client:      &http.Client{Timeout: 10 * time.Second},
+		MaxAttempts: 5,
+		Backoff:     time.Second,
+	}
+	if c != nil {
+		if c.Client != nil {
+			defaultCfg.Client = c.Client
+		}
+		if c.MaxAttempts > 0 {
+			defaultCfg.MaxAttempts = c.MaxAttempts
+		}
+		if c.Backoff > 0 {
+			defaultCfg.Backoff = c.Backoff
+		}
+	}
+
+	return &WebhookDefault{
+		rp:          rp,
+		client:      defaultCfg.Client,
+		maxAttempts: defaultCfg.MaxAttempts,
+		backoff:     defaultCfg.Backoff,
+		done:        make(chan struct{}),
+	}
+}
+
+// WebhookDefault is an struct that represents a webhook service.
+// Besides managing the webhooks, it sends them the vehicle events it listens to.
+type WebhookDefault struct {
+	rp internal.RepositoryWebhook
+	// client is the http client the events are sent with.
+	client *http.Client
+	// maxAttempts is the number of attempts before an event goes to the dead letters.
+	maxAttempts int
+	// backoff is the wait after the first failed attempt.
+	backoff time.Duration
+	// done is closed when the service stops listening, pending retries are abandoned.
+	done chan struct{}
+	// stopOnce guards done.
+	stopOnce sync.Once
+}
+
+// FindAll returns all webhooks.
+func (sv *WebhookDefault) FindAll() (w []internal.Webhook, err error) {
+	return sv.rp.FindAll()
+}
+
+// FindById returns the webhook with the given id.
+func (sv *WebhookDefault) FindById(id int) (w internal.Webhook, err error) {
+	w, err = sv.rp.FindById(id)
+	if err != nil {
+		err = webhookError(err)
+	}
+	return
+}
+
+// Insert creates a webhook, a random secret is generated if none is given.
+func (sv *WebhookDefault) Insert(a internal.WebhookAttributes) (nw internal.Webhook, err error) {
+	if a.Secret == "" {
+		b := make([]byte, 32)
+		if _, err = rand.Read(b); err != nil {
+			return
+		}
+		a.Secret = hex.EncodeToString(b)
+	}
+	if a, err = validateWebhook(a); err != nil {
+		return
+	}
+
+	nw, err = sv.rp.Insert(internal.Webhook{Attributes: a, CreatedAt: time.Now()})
+	return
+}
+
+// Update replaces the attributes of a webhook, the secret is kept if none is given.
+func (sv *WebhookDefault) Update(id int, a internal.WebhookAttributes) (uw internal.Webhook, err error) {
+	if a.Secret == "" {
+		var w internal.Webhook
+		w, err = sv.rp.FindById(id)
+		if err != nil {
+			err = webhookError(err)
+			return
+		}
+		a.Secret = w.Attributes.Secret
+	}
+	if a, err = validateWebhook(a); err != nil {
+		return
+	}
+
+	uw, err = sv.rp.Update(id, a)
+	if err != nil {
+		err = webhookError(err)
+	}
+	return
+}
+
+// Delete removes a webhook.
+func (sv *WebhookDefault) Delete(id int) (err error) {
+	if err = sv.rp.Delete(id); err != nil {
+		err = webhookError(err)
+	}
+	return
+}
+
+// FindDeliveries returns the last attempts to send events to a webhook, newest first.
+func (sv *WebhookDefault) FindDeliveries(webhookId int) (d []internal.WebhookDelivery, err error) {
+	d, err = sv.rp.FindDeliveries(webhookId)
+	if err != nil {
+		err = webhookError(err)
+	}
+	return
+}
+
+// FindDeadLetters returns the events that could not be sent to a webhook.
+func (sv *WebhookDefault) FindDeadLetters(webhookId int) (dl []internal.WebhookDeadLetter, err error) {
+	dl, err = sv.rp.FindDeadLetters(webhookId)
+	if err != nil {
+		err = webhookError(err)
+	}
+	return
+}
+
+// RetryDeadLetter removes a dead letter and sends its event again, in the background.
+func (sv *WebhookDefault) RetryDeadLetter(webhookId int, id int) (err error) {
+	dl, err := sv.rp.DeleteDeadLetter(webhookId, id)
+	if err != nil {
+		err = webhookError(err)
+		return
+	}
+
+	go sv.deliver(webhookId, dl.Event, dl.EventID, dl.Payload)
+	return
+}
+
+// Listen starts sending the events of the bus to the webhooks subscribed to them, until stop is called.
+// The events the bus discards before they are seen cannot be sent, they are logged as lost.
+func (sv *WebhookDefault) Listen(bus internal.EventBusVehicle) (stop func()) {
+	listen(bus, internal.VehicleEventFilter{}, sv.done, sv.dispatch, func(lastId int64) {
+		log.Printf("webhook: the events after %d expired before they were sent", lastId)
+	})
+	return func() {
+		sv.stopOnce.Do(func() { close(sv.done) })
+	}
+}
+
+// webhookPayloadJSON is an struct that represents the body posted to the webhooks.
+type webhookPayloadJSON struct {
+	Event      internal.WebhookEventType `json:"event"`
+	EventID    int64                     `json:"event_id"`
+	OccurredAt time.Time                 `json:"occurred_at"`
+	Data       struct {
+		VehicleID int                   `json:"vehicle_id"`
+		Changes   []string              `json:"changes"`
+		Vehicle   *internal.VehicleJSON `json:"vehicle,omitempty"`
+	} `json:"data"`
+}
+
+// webhookEvents returns the webhook events a vehicle event is notified as.
+func webhookEvents(e internal.VehicleEvent) (events []internal.WebhookEventType) {
+	switch e.Type {
+	case internal.VehicleEventCreated:
+		events = append(events, internal.WebhookEventVehicleCreated)
+	case internal.VehicleEventUpdated:
+		events = append(events, internal.WebhookEventVehicleUpdated)
+		for _, c := range e.Changes {
+			if c == "fuel_type" {
+				events = append(events, internal.WebhookEventVehicleFuelTypeChanged)
+			}
+		}
+	case internal.VehicleEventDeleted:
+		events = append(events, internal.WebhookEventVehicleDeleted)
+	}
+	return
+}
+
+// dispatch sends a vehicle event to the active webhooks subscribed to it, each one in the background.
+func (sv *WebhookDefault) dispatch(e internal.VehicleEvent) {
+	webhooks, err := sv.rp.FindAll()
+	if err != nil {
+		return
+	}
+
+	for _, t := range webhookEvents(e) {
+		// payload
+		payload := webhookPayloadJSON{Event: t, EventID: e.ID, OccurredAt: e.Time}
+		payload.Data.VehicleID = e.VehicleID
+		payload.Data.Changes = e.Changes
+		if payload.Data.Changes == nil {
+			payload.Data.Changes = []string{}
+		}
+		if e.Type != internal.VehicleEventDeleted {
+			v := internal.SerializeVehicle(e.Vehicle, internal.UnitSystemMetric)
+			payload.Data.Vehicle = &v
+		}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			continue
+		}
+
+		for _, w := range webhooks {
+			if !w.Attributes.Active || !containsEvent(w.Attributes.Events, t) {
+				continue
+			}
+			go sv.deliver(w.ID, t, e.ID, body)
+		}
+	}
+}
+
+// deliver sends the payload to the webhook, retrying with exponential backoff.
+// When all the attempts fail the event is saved as a dead letter.
+func (sv *WebhookDefault) deliver(webhookId int, t internal.WebhookEventType, eventId int64, payload []byte) {
+	var lastError string
+	backoff := sv.backoff
+	for attempt := 1; attempt <= sv.maxAttempts; attempt++ {
+		// the webhook is read on each attempt, it may have changed or been deleted
+		w, err := sv.rp.FindById(webhookId)
+		if err != nil {
+			return
+		}
+
+		d := sv.send(w, t, eventId, payload, attempt)
+		if d.Succeeded() {
+			return
+		}
+		lastError = d.Error
+
+		if attempt < sv.maxAttempts {
+			select {
+			case <-sv.done:
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+	}
+
+	_, _ = sv.rp.InsertDeadLetter(internal.WebhookDeadLetter{
+		WebhookID: webhookId,
+		Event:     t,
+		EventID:   eventId,
+		Payload:   payload,
+		Attempts:  sv.maxAttempts,
+		LastError: lastError,
+		Time:      time.Now(),
+	})
+}
+
+// send posts the payload signed with the secret of the webhook, saving the attempt.
+// The signature is the hex HMAC-SHA256 of "<timestamp>.<body>", sent as X-Webhook-Signature: sha256=<signature>.
+func (sv *WebhookDefault) send(w internal.Webhook, t internal.WebhookEventType, eventId int64, payload []byte, attempt int) (d internal.WebhookDelivery) {
+	d = internal.WebhookDelivery{
+		WebhookID: w.ID,
+		Event:     t,
+		EventID:   eventId,
+		Attempt:   attempt,
+		Time:      time.Now(),
+	}
+	defer func() {
+		d.Duration = time.Since(d.Time)
+		_, _ = sv.rp.InsertDelivery(d)
+	}()
+
+	// request
+	timestamp := strconv.FormatInt(d.Time.Unix(), 10)
+	req, err := http.NewRequest(http.MethodPost, w.Attributes.URL, bytes.NewReader(payload))
+	if err != nil {
+		d.Error = err.Error()
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", strconv.Itoa(w.ID))
+	req.Header.Set("X-Webhook-Event", string(t))
+	req.Header.Set("X-Webhook-Event-Id", strconv.FormatInt(eventId, 10))
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", "sha256="+Sign(w.Attributes.Secret, timestamp, payload))
+
+	// response
+	res, err := sv.client.Do(req)
+	if err != nil {
+		d.Error = err.Error()
+		return
+	}
+	defer res.Body.Close()
+	d.StatusCode = res.StatusCode
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		d.Error = fmt.Sprintf("unexpected status code %d", res.StatusCode)
+	}
+	return
+}
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<body>" with the secret, as receivers should compute it.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validateWebhook checks the attributes of a webhook, removing repeated events.
+func validateWebhook(a internal.WebhookAttributes) (va internal.WebhookAttributes, err error) {
+	u, e := url.Parse(a.URL)
+	if e != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		err = internal.ErrServiceInvalidWebhookURL
+		return
+	}
+	if len(a.Secret) < 16 {
+		err = internal.ErrServiceInvalidWebhookSecret
+		return
+	}
+	if len(a.Events) == 0 {
+		err = internal.ErrServiceInvalidWebhookEvents
+		return
+	}
+	events := make([]internal.WebhookEventType, 0, len(a.Events))
+	for _, event := range a.Events {
+		if !containsEvent(internal.WebhookEventTypes, event) {
+			err = internal.ErrServiceInvalidWebhookEvents
+			return
+		}
+		if !containsEvent(events, event) {
+			events = append(events, event)
+		}
+	}
+	a.Events = events
+
+	va = a
+	return
+}
+
+// containsEvent returns whether the event type is in the list.
+func containsEvent(events []internal.WebhookEventType, t internal.WebhookEventType) bool {
+	for _, e := range events {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookError maps the errors of the repository to the ones of the service.
+func webhookError(err error) error {
+	switch {
+	case errors.Is(err, internal.ErrRepositoryWebhookNotFound):
+		return internal.ErrServiceWebhookNotFound
+	case errors.Is(err, internal.ErrRepositoryWebhookDeadLetterNotFound):
+		return internal.ErrServiceWebhookDeadLetterNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"app/internal"
+	"app/internal/repository"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// receiver is an http server that records the requests posted to it and answers them with the given status codes.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []receivedRequest
+	// codes are the status codes of the responses, in order. The last one is repeated.
+	codes []int
+}
+
+// receivedRequest is a request recorded by a receiver.
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+	time   time.Time
+}
+
+func newReceiver(t *testing.T, codes ...int) *receiver {
+	r := &receiver{codes: codes}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body, time: time.Now()})
+		code := r.codes[min(len(r.requests), len(r.codes))-1]
+		r.mu.Unlock()
+		w.WriteHeader(code)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+// eventually fails the test if cond is not true within a second.
+func eventually(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// newTestWebhook returns a webhook service with a webhook subscribed to every event, posting to url.
+func newTestWebhook(t *testing.T, url string, maxAttempts int, backoff time.Duration) (sv *WebhookDefault, w internal.Webhook) {
+	sv = NewWebhookDefault(repository.NewWebhookMap(), &ConfigWebhookDefault{MaxAttempts: maxAttempts, Backoff: backoff})
+	t.Cleanup(func() { sv.stopOnce.Do(func() { close(sv.done) }) })
+	w, err := sv.Insert(internal.WebhookAttributes{
+		URL:    url,
+		Secret: "0123456789abcdef0123456789abcdef",
+		Events: internal.WebhookEventTypes,
+		Active: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+var testEvent = internal.VehicleEvent{
+	ID:        7,
+	Type:      internal.VehicleEventCreated,
+	VehicleID: 1,
+	Changes:   []string{"brand"},
+	Vehicle: internal.Vehicle{ID: 1, Attributes: internal.VehicleAttributes{
+		Brand: "Tesla", Model: "Model 3", FuelType: "electric", Height: 144, Width: 185, Weight: 1800,
+		BatteryCapacity: 75, Range: 500, ChargingConnector: "ccs2",
+	}},
+	Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func TestWebhookDefault_Signature(t *testing.T) {
+	rc := newReceiver(t, http.StatusOK)
+	sv, w := newTestWebhook(t, rc.URL, 1, time.Millisecond)
+
+	sv.dispatch(testEvent)
+	eventually(t, func() bool { return len(rc.received()) == 1 })
+
+	r := rc.received()[0]
+	if got := r.header.Get("X-Webhook-Event"); got != string(internal.WebhookEventVehicleCreated) {
+		t.Errorf("event header = %q", got)
+	}
+	if got := r.header.Get("X-Webhook-Event-Id"); got != "7" {
+		t.Errorf("event id header = %q", got)
+	}
+
+	// the signature is the hmac of "<timestamp>.<body>" with the secret
+	mac := hmac.New(sha256.New, []byte(w.Attributes.Secret))
+	mac.Write([]byte(r.header.Get("X-Webhook-Timestamp") + "."))
+	mac.Write(r.body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := r.header.Get("X-Webhook-Signature"); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+
+	// the vehicle is written as in the responses
+	var payload struct {
+		Event string `json:"event"`
+		Data  struct {
+			Vehicle internal.VehicleJSON `json:"vehicle"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(r.body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if want := internal.SerializeVehicle(testEvent.Vehicle, internal.UnitSystemMetric); payload.Data.Vehicle != want {
+		t.Errorf("vehicle = %+v, want %+v", payload.Data.Vehicle, want)
+	}
+}
+
+func TestWebhookDefault_Retry(t *testing.T) {
+	rc := newReceiver(t, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusOK)
+	backoff := 20 * time.Millisecond
+	sv, w := newTestWebhook(t, rc.URL, 5, backoff)
+
+	sv.dispatch(internal.VehicleEvent{ID: 1, Type: internal.VehicleEventDeleted, VehicleID: 1})
+	eventually(t, func() bool { return len(rc.received()) == 3 })
+
+	// the wait is doubled after each failed attempt
+	r := rc.received()
+	if d := r[1].time.Sub(r[0].time); d < backoff {
+		t.Errorf("first wait = %v, want at least %v", d, backoff)
+	}
+	if d := r[2].time.Sub(r[1].time); d < 2*backoff {
+		t.Errorf("second wait = %v, want at least %v", d, 2*backoff)
+	}
+
+	var deliveries []internal.WebhookDelivery
+	eventually(t, func() bool {
+		deliveries, _ = sv.FindDeliveries(w.ID)
+		return len(deliveries) == 3
+	})
+	if !deliveries[0].Succeeded() || deliveries[1].Succeeded() || deliveries[1].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("deliveries = %+v", deliveries)
+	}
+	if dl, _ := sv.FindDeadLetters(w.ID); len(dl) != 0 {
+		t.Errorf("dead letters = %+v, want none", dl)
+	}
+}
+
+func TestWebhookDefault_DeadLetter(t *testing.T) {
+	rc := newReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+	sv, w := newTestWebhook(t, rc.URL, 3, time.Millisecond)
+
+	sv.dispatch(internal.VehicleEvent{ID: 1, Type: internal.VehicleEventDeleted, VehicleID: 1})
+
+	// after all the attempts the event goes to the dead letters
+	var dl []internal.WebhookDeadLetter
+	eventually(t, func() bool {
+		dl, _ = sv.FindDeadLetters(w.ID)
+		return len(dl) == 1
+	})
+	if dl[0].Attempts != 3 || dl[0].Event != internal.WebhookEventVehicleDeleted || !strings.Contains(dl[0].LastError, "500") {
+		t.Errorf("dead letter = %+v", dl[0])
+	}
+	if n := len(rc.received()); n != 3 {
+		t.Errorf("requests = %d, want 3", n)
+	}
+
+	// retrying it sends the same payload again
+	if err := sv.RetryDeadLetter(w.ID, dl[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, func() bool { return len(rc.received()) == 4 })
+	r := rc.received()
+	if string(r[3].body) != string(r[0].body) {
+		t.Errorf("retried body = %s, want %s", r[3].body, r[0].body)
+	}
+	if dl, _ := sv.FindDeadLetters(w.ID); len(dl) != 0 {
+		t.Errorf("dead letters = %+v, want none", dl)
+	}
+}
@@ -20,12 +20,18 @@ type VehicleAttributes struct {
 	Transmission string
 	// Passengers is the capacity of passengers of the vehicle.
 	Passengers int
-	// Height is the height of the vehicle.
+	// Height is the height of the vehicle, in VehicleLengthUnit (centimeters).
 	Height float64
-	// Width is the width of the vehicle.
+	// Width is the width of the vehicle, in VehicleLengthUnit (centimeters).
 	Width float64
-	// Weight is the weight of the vehicle.
+	// Weight is the weight of the vehicle, in VehicleWeightUnit (kilograms).
 	Weight float64
+	// BatteryCapacity is the capacity of the battery of electric and hybrid vehicles, in kWh.
+	BatteryCapacity float64
+	// Range is the electric range of electric and hybrid vehicles, in km.
+	Range int
+	// ChargingConnector is the charging connector of electric and plug-in hybrid vehicles.
+	ChargingConnector string
 }
 
 // Vehicle is an struct that represents a vehicle.
@@ -34,4 +40,19 @@ type Vehicle struct {
 	ID int
 	// Attributes is the attributes of the vehicle.
 	Attributes VehicleAttributes
+	// Source is the data source the vehicle was loaded from (empty if it was created through the api).
+	Source string
+	// OwnerID is the identifier of the owner of the vehicle, 0 if it has none.
+	// Owners are only kept in memory, so it is not saved with the vehicle by loaders.
+	OwnerID int
+}
+
+// VehicleFuel is an struct that represents the fuel type of a vehicle with the attributes of its energy source.
+type VehicleFuel struct {
+	// FuelType is the fuel type of the vehicle.
+	FuelType string
+	// BatteryCapacity, Range and ChargingConnector are only set for electric and hybrid vehicles.
+	BatteryCapacity   float64
+	Range             int
+	ChargingConnector string
 }
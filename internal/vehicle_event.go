@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrEventBusVehicleEventsExpired is returned when the events after a given id are no longer kept.
+	ErrEventBusVehicleEventsExpired = errors.New("event bus: vehicle events expired")
+)
+
+// VehicleEventType is the kind of change of a vehicle.
+type VehicleEventType string
+
+const (
+	// VehicleEventCreated is the type of the events of new vehicles.
+	VehicleEventCreated VehicleEventType = "created"
+	// VehicleEventUpdated is the type of the events of vehicles whose attributes changed.
+	VehicleEventUpdated VehicleEventType = "updated"
+	// VehicleEventDeleted is the type of the events of deleted vehicles.
+	VehicleEventDeleted VehicleEventType = "deleted"
+)
+
+// VehicleEvent is an struct that represents a change of a vehicle.
+type VehicleEvent struct {
+	// ID is the sequence number of the event, assigned when it is published.
+	ID int64
+	// Type is the kind of change.
+	Type VehicleEventType
+	// VehicleID is the id of the vehicle that changed.
+	VehicleID int
+	// Changes are the names of the changed attributes (e.g. max_speed), all of them when the vehicle is created.
+	Changes []string
+	// Vehicle is the vehicle after the change, only the id is set when it is deleted.
+	Vehicle Vehicle
+	// Time is when the event was published.
+	Time time.Time
+}
+
+// VehicleEventFilter is an struct that represents the events a subscriber is interested in.
+// Empty fields match every event.
+type VehicleEventFilter struct {
+	// Types are the types of the events.
+	Types []VehicleEventType
+	// VehicleIDs are the ids of the vehicles.
+	VehicleIDs []int
+	// Changes are attribute names, events match if any of them changed.
+	Changes []string
+}
+
+// Match returns whether the event passes the filter.
+func (f VehicleEventFilter) Match(e VehicleEvent) bool {
+	if len(f.Types) > 0 && !contains(f.Types, e.Type) {
+		return false
+	}
+	if len(f.VehicleIDs) > 0 && !contains(f.VehicleIDs, e.VehicleID) {
+		return false
+	}
+	if len(f.Changes) > 0 {
+		for _, c := range e.Changes {
+			if contains(f.Changes, c) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// contains returns whether the slice contains the value.
+func contains[T comparable](s []T, v T) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// EventPublisherVehicle is the interface that wraps the basic methods to publish vehicle events.
+type EventPublisherVehicle interface {
+	// Publish sends the event to the subscribers, it never blocks
+	Publish(e VehicleEvent)
+}
+
+// EventBusVehicle is the interface that wraps the basic methods for a vehicle event bus.
+type EventBusVehicle interface {
+	EventPublisherVehicle
+	// Subscribe returns the kept events after lastId matching the filter and a channel with the next ones.
+	// The channel is closed when cancel is called or the subscriber falls behind.
+	// If events after lastId were already discarded, the kept ones are returned with ErrEventBusVehicleEventsExpired.
+	Subscribe(f VehicleEventFilter, lastId int64) (replay []VehicleEvent, ch <-chan VehicleEvent, cancel func(), err error)
+	// LastId returns the id of the last event published, 0 if there are not any.
+	LastId() (id int64)
+}
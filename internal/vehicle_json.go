@@ -0,0 +1,75 @@
+package internal
+
+import "math"
+
+// VehicleJSON is an struct that represents a vehicle in json format.
+// It is the representation shared by the responses, the events and the webhook payloads.
+type VehicleJSON struct {
+	ID           int     `json:"id" xml:"id" yaml:"id"`
+	Brand        string  `json:"brand" xml:"brand" yaml:"brand"`
+	Model        string  `json:"model" xml:"model" yaml:"model"`
+	Registration string  `json:"registration" xml:"registration" yaml:"registration"`
+	Year         int     `json:"year" xml:"year" yaml:"year"`
+	Color        string  `json:"color" xml:"color" yaml:"color"`
+	MaxSpeed     int     `json:"max_speed" xml:"max_speed" yaml:"max_speed"`
+	FuelType     string  `json:"fuel_type" xml:"fuel_type" yaml:"fuel_type"`
+	Transmission string  `json:"transmission" xml:"transmission" yaml:"transmission"`
+	Passengers   int     `json:"passengers" xml:"passengers" yaml:"passengers"`
+	Height       float64 `json:"height" xml:"height" yaml:"height"`
+	Width        float64 `json:"width" xml:"width" yaml:"width"`
+	Weight       float64 `json:"weight" xml:"weight" yaml:"weight"`
+	// BatteryCapacity, Range and ChargingConnector are only set for electric and hybrid vehicles.
+	BatteryCapacity   float64 `json:"battery_capacity,omitempty" xml:"battery_capacity,omitempty" yaml:"battery_capacity,omitempty"`
+	Range             int     `json:"range,omitempty" xml:"range,omitempty" yaml:"range,omitempty"`
+	ChargingConnector string  `json:"charging_connector,omitempty" xml:"charging_connector,omitempty" yaml:"charging_connector,omitempty"`
+	// LengthUnit and WeightUnit are the units the dimensions and weight are written in.
+	LengthUnit string `json:"length_unit" xml:"length_unit" yaml:"length_unit"`
+	WeightUnit string `json:"weight_unit" xml:"weight_unit" yaml:"weight_unit"`
+	// OwnerID is only set for vehicles with an owner.
+	OwnerID int `json:"owner_id,omitempty" xml:"owner_id,omitempty" yaml:"owner_id,omitempty"`
+	// Source is only set for vehicles loaded from a data source.
+	Source string `json:"source,omitempty" xml:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// SerializeVehicle returns the json representation of a vehicle, with the dimensions and weight in the units of us.
+func SerializeVehicle(v Vehicle, us UnitSystem) VehicleJSON {
+	lu, wu := us.Length(), us.Weight()
+	return VehicleJSON{
+		ID:                v.ID,
+		Brand:             v.Attributes.Brand,
+		Model:             v.Attributes.Model,
+		Registration:      v.Attributes.Registration,
+		Year:              v.Attributes.Year,
+		Color:             v.Attributes.Color,
+		MaxSpeed:          v.Attributes.MaxSpeed,
+		FuelType:          v.Attributes.FuelType,
+		Transmission:      v.Attributes.Transmission,
+		Passengers:        v.Attributes.Passengers,
+		Height:            convertVehicleLength(v.Attributes.Height, lu),
+		Width:             convertVehicleLength(v.Attributes.Width, lu),
+		Weight:            convertVehicleWeight(v.Attributes.Weight, wu),
+		BatteryCapacity:   v.Attributes.BatteryCapacity,
+		Range:             v.Attributes.Range,
+		ChargingConnector: v.Attributes.ChargingConnector,
+		LengthUnit:        string(lu),
+		WeightUnit:        string(wu),
+		OwnerID:           v.OwnerID,
+		Source:            v.Source,
+	}
+}
+
+// convertVehicleLength returns a length of a vehicle in another unit, rounded to hundredths as the stored ones.
+func convertVehicleLength(v float64, to LengthUnit) float64 {
+	if to == VehicleLengthUnit {
+		return v
+	}
+	return math.Round(VehicleLengthUnit.Convert(v, to)*100) / 100
+}
+
+// convertVehicleWeight returns a weight of a vehicle in another unit, rounded to hundredths as the stored ones.
+func convertVehicleWeight(v float64, to WeightUnit) float64 {
+	if to == VehicleWeightUnit {
+		return v
+	}
+	return math.Round(VehicleWeightUnit.Convert(v, to)*100) / 100
+}
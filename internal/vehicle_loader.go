@@ -1,5 +1,18 @@
 package internal
 
+import "errors"
+
+var (
+	// ErrLoaderVehicleConflict is returned when two sources contain a vehicle with the same id or registration.
+	ErrLoaderVehicleConflict = errors.New("loader: vehicle conflict between sources")
+	// ErrLoaderInvalidConflictPolicy is returned when a conflict policy is unknown.
+	ErrLoaderInvalidConflictPolicy = errors.New("loader: invalid conflict policy")
+	// ErrLoaderUnsupportedSchemaVersion is returned when a file has a schema version the loader does not know.
+	ErrLoaderUnsupportedSchemaVersion = errors.New("loader: unsupported schema version")
+	// ErrLoaderReadOnly is returned when trying to write a source that can only be read.
+	ErrLoaderReadOnly = errors.New("loader: source is read only")
+)
+
 // LoadData is an struct that represents the data of file.
 type LoadData struct {
 	// Data is the slice of vehicles.
@@ -12,4 +25,10 @@ type LoadData struct {
 type Loader interface {
 	// Load returns all vehicles
 	Load() (d LoadData, err error)
-}
\ No newline at end of file
+}
+
+// Saver is the interface that wraps the basic methods for a vehicle saver.
+type Saver interface {
+	// Save replaces the stored vehicles
+	Save(d LoadData) (err error)
+}
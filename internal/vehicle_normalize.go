@@ -0,0 +1,201 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// vehicleBrands are the canonical brands, by their folded names and aliases.
+var vehicleBrands = map[string]string{
+	"acura":         "Acura",
+	"aston martin":  "Aston Martin",
+	"audi":          "Audi",
+	"austin":        "Austin",
+	"bentley":       "Bentley",
+	"bmw":           "BMW",
+	"buick":         "Buick",
+	"cadillac":      "Cadillac",
+	"chevrolet":     "Chevrolet",
+	"chevy":         "Chevrolet",
+	"chrysler":      "Chrysler",
+	"daewoo":        "Daewoo",
+	"daihatsu":      "Daihatsu",
+	"dodge":         "Dodge",
+	"eagle":         "Eagle",
+	"ferrari":       "Ferrari",
+	"ford":          "Ford",
+	"geo":           "Geo",
+	"gmc":           "GMC",
+	"honda":         "Honda",
+	"hummer":        "Hummer",
+	"hyundai":       "Hyundai",
+	"infiniti":      "Infiniti",
+	"isuzu":         "Isuzu",
+	"jaguar":        "Jaguar",
+	"jeep":          "Jeep",
+	"jensen":        "Jensen",
+	"kia":           "Kia",
+	"lamborghini":   "Lamborghini",
+	"land rover":    "Land Rover",
+	"landrover":     "Land Rover",
+	"lexus":         "Lexus",
+	"lincoln":       "Lincoln",
+	"lotus":         "Lotus",
+	"maserati":      "Maserati",
+	"maybach":       "Maybach",
+	"mazda":         "Mazda",
+	"mercedes benz": "Mercedes-Benz",
+	"benz":          "Mercedes-Benz",
+	"mercedes":      "Mercedes-Benz",
+	"mercury":       "Mercury",
+	"mini":          "MINI",
+	"mitsubishi":    "Mitsubishi",
+	"nissan":        "Nissan",
+	"oldsmobile":    "Oldsmobile",
+	"panoz":         "Panoz",
+	"plymouth":      "Plymouth",
+	"pontiac":       "Pontiac",
+	"porsche":       "Porsche",
+	"ram":           "Ram",
+	"rambler":       "Rambler",
+	"renault":       "Renault",
+	"rolls royce":   "Rolls-Royce",
+	"saab":          "Saab",
+	"saturn":        "Saturn",
+	"subaru":        "Subaru",
+	"suzuki":        "Suzuki",
+	"tesla":         "Tesla",
+	"toyota":        "Toyota",
+	"volkswagen":    "Volkswagen",
+	"vw":            "Volkswagen",
+	"volvo":         "Volvo",
+}
+
+// vehicleColors are the canonical colors with another spelling, by their folded names and aliases.
+// Other colors are capitalized.
+var vehicleColors = map[string]string{
+	"grey":    "Gray",
+	"fuscia":  "Fuchsia",
+	"fuchsia": "Fuchsia",
+	"mauv":    "Mauve",
+}
+
+// vehicleFuelTypes are the canonical fuel types, by their folded names and aliases.
+var vehicleFuelTypes = map[string]string{
+	"gasoline":       "gasoline",
+	"gas":            "gasoline",
+	"petrol":         "gasoline",
+	"diesel":         "diesel",
+	"biodiesel":      "biodiesel",
+	"bio diesel":     "biodiesel",
+	"electric":       "electric",
+	"ev":             "electric",
+	"bev":            "electric",
+	"hybrid":         "hybrid",
+	"hev":            "hybrid",
+	"phev":           "hybrid",
+	"plug in hybrid": "hybrid",
+}
+
+// vehicleTransmissions are the canonical transmissions, by their folded names and aliases.
+var vehicleTransmissions = map[string]string{
+	"automatic":      "automatic",
+	"auto":           "automatic",
+	"semi automatic": "semi-automatic",
+	"semiautomatic":  "semi-automatic",
+	"semi auto":      "semi-automatic",
+	"manual":         "manual",
+	"stick":          "manual",
+}
+
+// vehicleChargingConnectors are the canonical charging connectors, by their folded names and aliases.
+var vehicleChargingConnectors = map[string]string{
+	"ccs1":        "CCS1",
+	"ccs 1":       "CCS1",
+	"ccs combo 1": "CCS1",
+	"ccs2":        "CCS2",
+	"ccs 2":       "CCS2",
+	"ccs combo 2": "CCS2",
+	"chademo":     "CHAdeMO",
+	"gb/t":        "GB/T",
+	"gbt":         "GB/T",
+	"nacs":        "NACS",
+	"type 1":      "Type 1",
+	"type1":       "Type 1",
+	"j1772":       "Type 1",
+	"type 2":      "Type 2",
+	"type2":       "Type 2",
+	"mennekes":    "Type 2",
+}
+
+// fold returns a categorical value in lowercase, with its words separated by a single space
+// (hyphens and underscores separate words too), so different writings of a value are equal.
+func fold(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == '_'
+	}), " ")
+}
+
+// NormalizeBrand returns the canonical brand of a value (e.g. chevy is Chevrolet).
+// Unknown brands are only trimmed.
+func NormalizeBrand(s string) string {
+	if b, ok := vehicleBrands[fold(s)]; ok {
+		return b
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// NormalizeColor returns the canonical color of a value, capitalized (e.g. light blue is Light Blue).
+func NormalizeColor(s string) string {
+	f := fold(s)
+	if c, ok := vehicleColors[f]; ok {
+		return c
+	}
+	words := strings.Fields(f)
+	for i, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// NormalizeFuelType returns the canonical fuel type of a value (e.g. gas is gasoline).
+// Unknown fuel types are folded.
+func NormalizeFuelType(s string) string {
+	f := fold(s)
+	if ft, ok := vehicleFuelTypes[f]; ok {
+		return ft
+	}
+	return strings.ReplaceAll(f, " ", "-")
+}
+
+// NormalizeTransmission returns the canonical transmission of a value (e.g. Semi Automatic is semi-automatic).
+// Unknown transmissions are folded.
+func NormalizeTransmission(s string) string {
+	f := fold(s)
+	if t, ok := vehicleTransmissions[f]; ok {
+		return t
+	}
+	return strings.ReplaceAll(f, " ", "-")
+}
+
+// NormalizeChargingConnector returns the canonical charging connector of a value (e.g. j1772 is Type 1).
+// Unknown connectors are only trimmed.
+func NormalizeChargingConnector(s string) string {
+	if c, ok := vehicleChargingConnectors[fold(s)]; ok {
+		return c
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// NormalizeVehicleAttributes returns the attributes with the canonical brand, color, fuel type, transmission
+// and charging connector.
+func NormalizeVehicleAttributes(a VehicleAttributes) VehicleAttributes {
+	a.Brand = NormalizeBrand(a.Brand)
+	a.Color = NormalizeColor(a.Color)
+	a.FuelType = NormalizeFuelType(a.FuelType)
+	a.Transmission = NormalizeTransmission(a.Transmission)
+	a.ChargingConnector = NormalizeChargingConnector(a.ChargingConnector)
+	return a
+}
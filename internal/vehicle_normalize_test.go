@@ -0,0 +1,65 @@
+package internal
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		normalize func(s string) string
+		s         string
+		want      string
+	}{
+		{"brand", NormalizeBrand, "ford", "Ford"},
+		{"brand alias", NormalizeBrand, " Chevy ", "Chevrolet"},
+		{"brand with hyphens", NormalizeBrand, "mercedes-benz", "Mercedes-Benz"},
+		{"brand with underscores", NormalizeBrand, "LAND_ROVER", "Land Rover"},
+		{"brand unknown", NormalizeBrand, "  De   Tomaso ", "De Tomaso"},
+
+		{"color", NormalizeColor, "green", "Green"},
+		{"color of several words", NormalizeColor, "light  BLUE", "Light Blue"},
+		{"color with hyphens", NormalizeColor, "dark-red", "Dark Red"},
+		{"color alias", NormalizeColor, "Grey", "Gray"},
+		{"color misspelled", NormalizeColor, "fuscia", "Fuchsia"},
+		{"color empty", NormalizeColor, " ", ""},
+
+		{"fuel type", NormalizeFuelType, "Gasoline", "gasoline"},
+		{"fuel type alias", NormalizeFuelType, "gas", "gasoline"},
+		{"fuel type alias of several words", NormalizeFuelType, "Plug-In Hybrid", "hybrid"},
+		{"fuel type acronym", NormalizeFuelType, "EV", "electric"},
+		{"fuel type unknown", NormalizeFuelType, "Liquefied Petroleum", "liquefied-petroleum"},
+
+		{"transmission", NormalizeTransmission, "MANUAL", "manual"},
+		{"transmission alias", NormalizeTransmission, "auto", "automatic"},
+		{"transmission of several words", NormalizeTransmission, "Semi Automatic", "semi-automatic"},
+		{"transmission unknown", NormalizeTransmission, "dual clutch", "dual-clutch"},
+
+		{"charging connector", NormalizeChargingConnector, "chademo", "CHAdeMO"},
+		{"charging connector alias", NormalizeChargingConnector, "J1772", "Type 1"},
+		{"charging connector unknown", NormalizeChargingConnector, " Tesla  Supercharger ", "Tesla Supercharger"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.normalize(tt.s); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+			// - canonical values are kept
+			if got := tt.normalize(tt.want); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want it kept", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeVehicleAttributes(t *testing.T) {
+	a := VehicleAttributes{
+		Brand: "vw", Model: "e-Golf", Registration: "abc 123", Color: "grey", FuelType: "BEV", Transmission: "Auto",
+		ChargingConnector: "ccs combo 2",
+	}
+	want := VehicleAttributes{
+		Brand: "Volkswagen", Model: "e-Golf", Registration: "abc 123", Color: "Gray", FuelType: "electric", Transmission: "automatic",
+		ChargingConnector: "CCS2",
+	}
+	if got := NormalizeVehicleAttributes(a); got != want {
+		t.Errorf("attributes = %+v, want %+v", got, want)
+	}
+}
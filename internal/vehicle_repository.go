@@ -15,9 +15,19 @@ var (
 type RepositoryVehicle interface {
 	// FindAll returns all vehicles
 	FindAll() (v []Vehicle, err error)
+	// FindById returns the vehicle with the given id
+	FindById(id int) (v Vehicle, err error)
 	Insert(v Vehicle) (nv Vehicle, err error)
 	InsertMany(v []Vehicle) (nvs []Vehicle, err error)
 	UpdateMaxSpeedById(id int, ms int) (uv Vehicle, err error)
 	Delete(id int) (err error)
-	UpdateFuelTypeById(id int, ft string) (uv Vehicle, err error)
+	// UpdateFuelTypeById changes the fuel type and energy attributes of a vehicle with update, which is called
+	// with a copy of its attributes while the vehicle cannot change. Nothing is changed if update returns an error.
+	UpdateFuelTypeById(id int, update func(a *VehicleAttributes) (err error)) (uv Vehicle, err error)
+	// UpdateOwnerById sets the owner of a vehicle, 0 removing it
+	UpdateOwnerById(id int, ownerId int) (uv Vehicle, err error)
+	// ForEach calls fn with each vehicle of the database when it is called until fn returns an error, without copying the whole database
+	ForEach(fn func(v Vehicle) (err error)) (err error)
+	// Search returns the vehicles matching the words of a query, from the most to the least relevant
+	Search(q string, limit int) (s []VehicleSearchResult, err error)
 }
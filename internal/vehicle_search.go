@@ -0,0 +1,9 @@
+package internal
+
+// VehicleSearchResult is an struct that represents a vehicle matching a search.
+type VehicleSearchResult struct {
+	// Vehicle is the vehicle found.
+	Vehicle Vehicle
+	// Score is the relevance of the vehicle for the search, the higher the more relevant.
+	Score float64
+}
@@ -6,21 +6,35 @@ import (
 
 var (
 	// ErrServiceVehicleNotFound is returned when no vehicle is found.
-	ErrServiceVehiclesNotFound           = errors.New("service: vehicles not found")
-	ErrServiceInvalidVehicleBrand        = errors.New("service: invalid vehicle brand")
-	ErrServiceInvalidVehicleModel        = errors.New("service: invalid vehicle model")
-	ErrServiceInvalidVehicleRegistration = errors.New("service: invalid vehicle registration")
-	ErrServiceInvalidVehicleYear         = errors.New("service: invalid vehicle year")
-	ErrServiceInvalidVehicleColor        = errors.New("service: invalid vehicle color")
-	ErrServiceInvalidVehicleMaxSpeed     = errors.New("service: invalid vehicle max speed")
-	ErrServiceInvalidVehicleFuelType     = errors.New("service: invalid vehicle fuel type")
-	ErrServiceInvalidVehicleTransmission = errors.New("service: invalid vehicle transmission")
-	ErrServiceInvalidVehiclePassengers   = errors.New("service: invalid vehicle passengers")
-	ErrServiceInvalidVehicleHeight       = errors.New("service: invalid vehicle height")
-	ErrServiceInvalidVehicleWidth        = errors.New("service: invalid vehicle width")
-	ErrServiceInvalidVehicleWeight       = errors.New("service: invalid vehicle weight")
-	ErrServiceVehicleIdAlreadyExists     = errors.New("service: vehicle id already exists")
-	ErrServiceVehicleNotFound            = errors.New("service: vehicle not found")
+	ErrServiceVehiclesNotFound                = errors.New("service: vehicles not found")
+	ErrServiceInvalidVehicleBrand             = errors.New("service: invalid vehicle brand")
+	ErrServiceInvalidVehicleModel             = errors.New("service: invalid vehicle model")
+	ErrServiceInvalidVehicleRegistration      = errors.New("service: invalid vehicle registration")
+	ErrServiceInvalidVehicleYear              = errors.New("service: invalid vehicle year")
+	ErrServiceInvalidVehicleColor             = errors.New("service: invalid vehicle color")
+	ErrServiceInvalidVehicleMaxSpeed          = errors.New("service: invalid vehicle max speed")
+	ErrServiceInvalidVehicleFuelType          = errors.New("service: invalid vehicle fuel type")
+	ErrServiceInvalidVehicleTransmission      = errors.New("service: invalid vehicle transmission")
+	ErrServiceInvalidVehiclePassengers        = errors.New("service: invalid vehicle passengers")
+	ErrServiceInvalidVehicleHeight            = errors.New("service: invalid vehicle height")
+	ErrServiceInvalidVehicleWidth             = errors.New("service: invalid vehicle width")
+	ErrServiceInvalidVehicleWeight            = errors.New("service: invalid vehicle weight")
+	ErrServiceInvalidVehicleBatteryCapacity   = errors.New("service: invalid vehicle battery capacity")
+	ErrServiceInvalidVehicleRange             = errors.New("service: invalid vehicle range")
+	ErrServiceInvalidVehicleChargingConnector = errors.New("service: invalid vehicle charging connector")
+	ErrServiceInvalidVehicleOwner             = errors.New("service: invalid vehicle owner")
+	ErrServiceVehicleIdAlreadyExists          = errors.New("service: vehicle id already exists")
+	ErrServiceVehicleNotFound                 = errors.New("service: vehicle not found")
+	ErrServiceInvalidStatsGroupBy             = errors.New("service: invalid stats group by")
+	ErrServiceInvalidStatsMetric              = errors.New("service: invalid stats metric")
+	ErrServiceInvalidHistogramAttribute       = errors.New("service: invalid histogram attribute")
+	ErrServiceInvalidHistogramBuckets         = errors.New("service: invalid histogram buckets")
+	ErrServiceInvalidHistogramFilter          = errors.New("service: invalid histogram filter")
+	ErrServiceInvalidHistogramGroupBy         = errors.New("service: invalid histogram group by")
+	ErrServiceInvalidSearchQuery              = errors.New("service: invalid search query")
+	ErrServiceInvalidSearchLimit              = errors.New("service: invalid search limit")
+	ErrServiceInvalidSimilarWeights           = errors.New("service: invalid similar weights")
+	ErrServiceInvalidSimilarLimit             = errors.New("service: invalid similar limit")
 )
 
 // ServiceVehicle is the interface that wraps the basic methods for a vehicle service.
@@ -31,15 +45,35 @@ type ServiceVehicle interface {
 	FindAll() (v []Vehicle, err error)
 	Insert(v Vehicle) (nv Vehicle, err error)
 	FindAllByColorAndYear(c string, y int) (v []Vehicle, err error)
-	FindAllByBrandAndBetweenYears(b string, sy int, ey int) (v []Vehicle, err error)
+	// FindAllByBrandAndBetweenYears returns the vehicles of a brand made within an interval of years
+	FindAllByBrandAndBetweenYears(b string, years Interval) (v []Vehicle, err error)
 	CalculateAverageSpeedByBrand(b string) (avg float64, err error)
 	InsertMany(v []Vehicle) (nvs []Vehicle, err error)
 	UpdateMaxSpeedById(id int, ms int) (uv Vehicle, err error)
 	FindAllByFuelType(ft string) (v []Vehicle, err error)
 	Delete(id int) (err error)
 	FindAllByTransmission(t string) (v []Vehicle, err error)
-	UpdateFuelTypeById(id int, ft string) (uv Vehicle, err error)
+	// UpdateFuelTypeById sets the fuel type of a vehicle, its energy attributes replacing the ones it had
+	UpdateFuelTypeById(id int, f VehicleFuel) (uv Vehicle, err error)
 	CalculateAverageCapacityByBrand(b string) (avg float64, err error)
-	FindAllByDimensions(minH, maxH, minW, maxW float64) (v []Vehicle, err error)
-	FindAllByWeight(minW, maxW float64) (v []Vehicle, err error)
+	// UpdateOwnerById sets the owner of a vehicle, 0 removing it
+	UpdateOwnerById(id int, ownerId int) (uv Vehicle, err error)
+	// FindAllByDimensions returns the vehicles with height and width within the intervals, in VehicleLengthUnit
+	FindAllByDimensions(height, width Interval) (v []Vehicle, err error)
+	// FindAllByWeight returns the vehicles with weight within the interval, in VehicleWeightUnit
+	FindAllByWeight(weight Interval) (v []Vehicle, err error)
+	// FindAllByChargingConnector returns the vehicles that charge with a connector
+	FindAllByChargingConnector(c string) (v []Vehicle, err error)
+	// FindAllByRange returns the vehicles with an electric range within the interval
+	FindAllByRange(r Interval) (v []Vehicle, err error)
+	// ForEach calls fn with each vehicle as it is read until fn returns an error
+	ForEach(fn func(v Vehicle) (err error)) (err error)
+	// CalculateStats returns the metrics of each group of vehicles, computed in a single pass
+	CalculateStats(q VehicleStatsQuery) (s []VehicleStats, err error)
+	// CalculateHistogram returns the distribution of a numeric attribute in each group of vehicles
+	CalculateHistogram(q VehicleHistogramQuery) (h []VehicleHistogram, err error)
+	// Search returns the vehicles matching a text, with typos, from the most to the least relevant
+	Search(q string, limit int) (s []VehicleSearchResult, err error)
+	// FindAllSimilar returns the other vehicles from the most to the least like a vehicle, by a weighted distance
+	FindAllSimilar(q VehicleSimilarQuery) (s []VehicleSimilarResult, err error)
 }
@@ -0,0 +1,32 @@
+package internal
+
+// DefaultVehicleSimilarWeights returns the weights of the attributes in the distance between two vehicles, by name:
+// brand, year, passengers, transmission, fuel_type and dimensions (height and width).
+func DefaultVehicleSimilarWeights() map[string]float64 {
+	return map[string]float64{
+		"brand":        3,
+		"year":         2,
+		"passengers":   2,
+		"transmission": 1,
+		"fuel_type":    1,
+		"dimensions":   1,
+	}
+}
+
+// VehicleSimilarQuery is an struct that represents the vehicles like another one to find.
+type VehicleSimilarQuery struct {
+	// ID is the identifier of the vehicle the others are compared to.
+	ID int
+	// Weights are the weights of the attributes in the distance, by name. Attributes without one keep the default weight.
+	Weights map[string]float64
+	// Limit is the max number of vehicles, all of them if 0.
+	Limit int
+}
+
+// VehicleSimilarResult is an struct that represents a vehicle like another one.
+type VehicleSimilarResult struct {
+	// Vehicle is the vehicle found.
+	Vehicle Vehicle
+	// Distance is the weighted distance to the vehicle of the query, from 0 (the same attributes) to 1.
+	Distance float64
+}
@@ -0,0 +1,69 @@
+package internal
+
+// VehicleStatsMetric is an struct that represents an aggregate of a numeric attribute of the vehicles.
+type VehicleStatsMetric struct {
+	// Aggregate is the function applied: count, sum, min, max, avg (or mean), median, stddev or a percentile (p1 to p99).
+	Aggregate string
+	// Attribute is the name of the numeric attribute (e.g. max_speed), empty for count.
+	Attribute string
+}
+
+// Name returns the name of the metric in the results (e.g. avg_max_speed), count when there is not any attribute.
+func (m VehicleStatsMetric) Name() string {
+	if m.Attribute == "" {
+		return m.Aggregate
+	}
+	return m.Aggregate + "_" + m.Attribute
+}
+
+// VehicleStatsQuery is an struct that represents the metrics to calculate for each group of vehicles.
+type VehicleStatsQuery struct {
+	// GroupBy are the names of the categorical attributes the vehicles are grouped by, all of them in a group if empty.
+	GroupBy []string
+	// Metrics are the aggregates calculated for each group.
+	Metrics []VehicleStatsMetric
+}
+
+// VehicleStats is an struct that represents the metrics of a group of vehicles.
+type VehicleStats struct {
+	// Group are the values of the attributes of the group, in the order of the query.
+	Group []string
+	// Count is the number of vehicles of the group.
+	Count int
+	// Values are the values of the metrics, in the order of the query.
+	Values []float64
+}
+
+// VehicleHistogramQuery is an struct that represents the distribution of a numeric attribute to calculate.
+type VehicleHistogramQuery struct {
+	// Attribute is the name of the numeric attribute (e.g. weight).
+	Attribute string
+	// Buckets is the number of buckets of the same width between the min and max values, used if there are not any edges.
+	Buckets int
+	// Edges are the ascending bounds of the buckets, values outside of them are not counted.
+	Edges []float64
+	// Filter are the values the categorical attributes of the vehicles must have, by attribute name.
+	Filter map[string]string
+	// GroupBy are the names of the categorical attributes the vehicles are grouped by, all of them in a group if empty.
+	GroupBy []string
+}
+
+// VehicleHistogramBucket is an struct that represents a range of values of a histogram.
+// Buckets include their min, only the last one includes its max.
+type VehicleHistogramBucket struct {
+	// Min is the lower bound of the bucket.
+	Min float64
+	// Max is the upper bound of the bucket.
+	Max float64
+	// Count is the number of vehicles with a value in the bucket.
+	Count int
+}
+
+// VehicleHistogram is an struct that represents the distribution of an attribute in a group of vehicles.
+// All groups have the same buckets.
+type VehicleHistogram struct {
+	// Group are the values of the attributes of the group, in the order of the query.
+	Group []string
+	// Buckets are the buckets, in ascending order.
+	Buckets []VehicleHistogramBucket
+}
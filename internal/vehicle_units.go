@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidUnit is returned when a unit or a value with a unit is not valid.
+var ErrInvalidUnit = errors.New("units: invalid unit")
+
+// LengthUnit is a unit of length.
+type LengthUnit string
+
+const (
+	// LengthUnitMillimeter is millimeters.
+	LengthUnitMillimeter LengthUnit = "mm"
+	// LengthUnitCentimeter is centimeters.
+	LengthUnitCentimeter LengthUnit = "cm"
+	// LengthUnitMeter is meters.
+	LengthUnitMeter LengthUnit = "m"
+	// LengthUnitInch is inches.
+	LengthUnitInch LengthUnit = "in"
+	// LengthUnitFoot is feet.
+	LengthUnitFoot LengthUnit = "ft"
+)
+
+// lengthUnitCentimeters are the centimeters of each unit of length.
+var lengthUnitCentimeters = map[LengthUnit]float64{
+	LengthUnitMillimeter: 0.1,
+	LengthUnitCentimeter: 1,
+	LengthUnitMeter:      100,
+	LengthUnitInch:       2.54,
+	LengthUnitFoot:       30.48,
+}
+
+// Valid returns true if the unit is known.
+func (u LengthUnit) Valid() bool {
+	_, ok := lengthUnitCentimeters[u]
+	return ok
+}
+
+// Convert returns a length in the unit converted to another unit.
+func (u LengthUnit) Convert(v float64, to LengthUnit) float64 {
+	if u == to {
+		return v
+	}
+	return v * lengthUnitCentimeters[u] / lengthUnitCentimeters[to]
+}
+
+// WeightUnit is a unit of weight.
+type WeightUnit string
+
+const (
+	// WeightUnitGram is grams.
+	WeightUnitGram WeightUnit = "g"
+	// WeightUnitKilogram is kilograms.
+	WeightUnitKilogram WeightUnit = "kg"
+	// WeightUnitTonne is metric tonnes.
+	WeightUnitTonne WeightUnit = "t"
+	// WeightUnitPound is pounds.
+	WeightUnitPound WeightUnit = "lb"
+)
+
+// weightUnitKilograms are the kilograms of each unit of weight.
+var weightUnitKilograms = map[WeightUnit]float64{
+	WeightUnitGram:     0.001,
+	WeightUnitKilogram: 1,
+	WeightUnitTonne:    1000,
+	WeightUnitPound:    0.45359237,
+}
+
+// Valid returns true if the unit is known.
+func (u WeightUnit) Valid() bool {
+	_, ok := weightUnitKilograms[u]
+	return ok
+}
+
+// Convert returns a weight in the unit converted to another unit.
+func (u WeightUnit) Convert(v float64, to WeightUnit) float64 {
+	if u == to {
+		return v
+	}
+	return v * weightUnitKilograms[u] / weightUnitKilograms[to]
+}
+
+const (
+	// VehicleLengthUnit is the unit the height and width of the vehicles are stored in.
+	VehicleLengthUnit = LengthUnitCentimeter
+	// VehicleWeightUnit is the unit the weight of the vehicles is stored in.
+	VehicleWeightUnit = WeightUnitKilogram
+)
+
+// UnitSystem is a system of units the dimensions and weights of the vehicles are written in.
+type UnitSystem string
+
+const (
+	// UnitSystemMetric writes lengths in centimeters and weights in kilograms, the units vehicles are stored in.
+	UnitSystemMetric UnitSystem = "metric"
+	// UnitSystemImperial writes lengths in inches and weights in pounds.
+	UnitSystemImperial UnitSystem = "imperial"
+)
+
+// Valid returns true if the system is known.
+func (s UnitSystem) Valid() bool {
+	return s == UnitSystemMetric || s == UnitSystemImperial
+}
+
+// Length returns the unit of the lengths of the system.
+func (s UnitSystem) Length() LengthUnit {
+	if s == UnitSystemImperial {
+		return LengthUnitInch
+	}
+	return LengthUnitCentimeter
+}
+
+// Weight returns the unit of the weights of the system.
+func (s UnitSystem) Weight() WeightUnit {
+	if s == UnitSystemImperial {
+		return WeightUnitPound
+	}
+	return WeightUnitKilogram
+}
+
+// unitAliases are other writings of the units, by their lowercase symbol.
+var unitAliases = map[string]string{
+	"lbs": "lb",
+	"kgs": "kg",
+}
+
+// splitQuantity returns the number and the lowercase unit suffix of a value (e.g. 1.5m is 1.5 and m).
+func splitQuantity(s string) (n float64, unit string, err error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i == -1 {
+		i = len(s)
+	}
+	unit = strings.ToLower(strings.TrimSpace(s[i:]))
+	if a, ok := unitAliases[unit]; ok {
+		unit = a
+	}
+	n, err = strconv.ParseFloat(s[:i], 64)
+	return
+}
+
+// ParseLength returns the length of a value with an optional unit suffix (e.g. 1.5m or 60in) converted to the unit to.
+// Values without a unit are in the unit def.
+func ParseLength(s string, def, to LengthUnit) (v float64, err error) {
+	n, unit, err := splitQuantity(s)
+	if err != nil {
+		return
+	}
+	u := def
+	if unit != "" {
+		u = LengthUnit(unit)
+	}
+	if !u.Valid() {
+		err = fmt.Errorf("%w: %q is not a unit of length", ErrInvalidUnit, unit)
+		return
+	}
+	v = u.Convert(n, to)
+	return
+}
+
+// ParseWeight returns the weight of a value with an optional unit suffix (e.g. 1.2t or 3000lb) converted to the unit to.
+// Values without a unit are in the unit def.
+func ParseWeight(s string, def, to WeightUnit) (v float64, err error) {
+	n, unit, err := splitQuantity(s)
+	if err != nil {
+		return
+	}
+	u := def
+	if unit != "" {
+		u = WeightUnit(unit)
+	}
+	if !u.Valid() {
+		err = fmt.Errorf("%w: %q is not a unit of weight", ErrInvalidUnit, unit)
+		return
+	}
+	v = u.Convert(n, to)
+	return
+}
@@ -0,0 +1,94 @@
+package internal
+
+import "time"
+
+// WebhookEventType is the kind of vehicle change a webhook can be notified of.
+type WebhookEventType string
+
+const (
+	// WebhookEventVehicleCreated is sent when a vehicle is created.
+	WebhookEventVehicleCreated WebhookEventType = "vehicle.created"
+	// WebhookEventVehicleUpdated is sent when any attribute of a vehicle changes.
+	WebhookEventVehicleUpdated WebhookEventType = "vehicle.updated"
+	// WebhookEventVehicleFuelTypeChanged is sent when the fuel type of a vehicle changes.
+	WebhookEventVehicleFuelTypeChanged WebhookEventType = "vehicle.fuel_type_changed"
+	// WebhookEventVehicleDeleted is sent when a vehicle is deleted.
+	WebhookEventVehicleDeleted WebhookEventType = "vehicle.deleted"
+)
+
+// WebhookEventTypes are all the event types a webhook can subscribe to.
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventVehicleCreated,
+	WebhookEventVehicleUpdated,
+	WebhookEventVehicleFuelTypeChanged,
+	WebhookEventVehicleDeleted,
+}
+
+// WebhookAttributes is an struct that represents the attributes of a webhook.
+type WebhookAttributes struct {
+	// URL is where the events are posted.
+	URL string
+	// Secret is the key the payloads are signed with (HMAC-SHA256).
+	Secret string
+	// Events are the types of events the webhook is subscribed to.
+	Events []WebhookEventType
+	// Active is whether the events are being sent.
+	Active bool
+}
+
+// Webhook is an struct that represents a subscription of an url to vehicle events.
+type Webhook struct {
+	// ID is the unique identifier of the webhook.
+	ID int
+	// Attributes is the attributes of the webhook.
+	Attributes WebhookAttributes
+	// CreatedAt is when the webhook was created.
+	CreatedAt time.Time
+}
+
+// WebhookDelivery is an struct that represents an attempt to send an event to a webhook.
+type WebhookDelivery struct {
+	// ID is the unique identifier of the attempt.
+	ID int
+	// WebhookID is the webhook the event was sent to.
+	WebhookID int
+	// Event is the type of the event.
+	Event WebhookEventType
+	// EventID is the id of the vehicle event that originated it.
+	EventID int64
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+	// StatusCode is the status of the response, 0 if there was not any.
+	StatusCode int
+	// Error is why the attempt failed, empty if it succeeded.
+	Error string
+	// Duration is how long the attempt took.
+	Duration time.Duration
+	// Time is when the attempt was made.
+	Time time.Time
+}
+
+// Succeeded returns whether the receiver accepted the event.
+func (d WebhookDelivery) Succeeded() bool {
+	return d.Error == ""
+}
+
+// WebhookDeadLetter is an struct that represents an event that could not be sent after all the attempts.
+type WebhookDeadLetter struct {
+	// ID is the unique identifier of the dead letter.
+	ID int
+	// WebhookID is the webhook the event was for.
+	WebhookID int
+	// Event is the type of the event.
+	Event WebhookEventType
+	// EventID is the id of the vehicle event that originated it.
+	EventID int64
+	// Payload is the body that was sent.
+	Payload []byte
+	// Attempts is the number of attempts made.
+	Attempts int
+	// LastError is why the last attempt failed.
+	LastError string
+	// Time is when the event was given up.
+	Time time.Time
+}
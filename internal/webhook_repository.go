@@ -0,0 +1,34 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrRepositoryWebhookNotFound is returned when a webhook is not found.
+	ErrRepositoryWebhookNotFound = errors.New("repository: webhook not found")
+	// ErrRepositoryWebhookDeadLetterNotFound is returned when a dead letter is not found.
+	ErrRepositoryWebhookDeadLetterNotFound = errors.New("repository: webhook dead letter not found")
+)
+
+// RepositoryWebhook is the interface that wraps the basic methods for a webhook repository.
+type RepositoryWebhook interface {
+	// FindAll returns all webhooks
+	FindAll() (w []Webhook, err error)
+	// FindById returns the webhook with the given id
+	FindById(id int) (w Webhook, err error)
+	// Insert saves a new webhook, assigning its id
+	Insert(w Webhook) (nw Webhook, err error)
+	// Update replaces the attributes of a webhook
+	Update(id int, a WebhookAttributes) (uw Webhook, err error)
+	// Delete removes a webhook with its deliveries and dead letters
+	Delete(id int) (err error)
+	// InsertDelivery saves an attempt to send an event
+	InsertDelivery(d WebhookDelivery) (nd WebhookDelivery, err error)
+	// FindDeliveries returns the last attempts of a webhook, newest first
+	FindDeliveries(webhookId int) (d []WebhookDelivery, err error)
+	// InsertDeadLetter saves an event that could not be sent
+	InsertDeadLetter(dl WebhookDeadLetter) (ndl WebhookDeadLetter, err error)
+	// FindDeadLetters returns the dead letters of a webhook
+	FindDeadLetters(webhookId int) (dl []WebhookDeadLetter, err error)
+	// DeleteDeadLetter removes a dead letter, returning it
+	DeleteDeadLetter(webhookId int, id int) (dl WebhookDeadLetter, err error)
+}
@@ -0,0 +1,26 @@
+package internal
+
+import "errors"
+
+var (
+	// ErrServiceWebhookNotFound is returned when a webhook is not found.
+	ErrServiceWebhookNotFound           = errors.New("service: webhook not found")
+	ErrServiceWebhookDeadLetterNotFound = errors.New("service: webhook dead letter not found")
+	ErrServiceInvalidWebhookURL         = errors.New("service: invalid webhook url")
+	ErrServiceInvalidWebhookSecret      = errors.New("service: invalid webhook secret")
+	ErrServiceInvalidWebhookEvents      = errors.New("service: invalid webhook events")
+)
+
+// ServiceWebhook is the interface that wraps the basic methods for a webhook service.
+type ServiceWebhook interface {
+	// FindAll returns all webhooks
+	FindAll() (w []Webhook, err error)
+	FindById(id int) (w Webhook, err error)
+	Insert(a WebhookAttributes) (nw Webhook, err error)
+	Update(id int, a WebhookAttributes) (uw Webhook, err error)
+	Delete(id int) (err error)
+	FindDeliveries(webhookId int) (d []WebhookDelivery, err error)
+	FindDeadLetters(webhookId int) (dl []WebhookDeadLetter, err error)
+	// RetryDeadLetter sends a dead letter again, it is removed and retried with the usual attempts
+	RetryDeadLetter(webhookId int, id int) (err error)
+}
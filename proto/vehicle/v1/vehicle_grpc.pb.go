@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: vehicle/v1/vehicle.proto
+
+package vehiclev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	VehicleService_FindAll_FullMethodName                         = "/vehicle.v1.VehicleService/FindAll"
+	VehicleService_Insert_FullMethodName                          = "/vehicle.v1.VehicleService/Insert"
+	VehicleService_FindAllByColorAndYear_FullMethodName           = "/vehicle.v1.VehicleService/FindAllByColorAndYear"
+	VehicleService_FindAllByBrandAndBetweenYears_FullMethodName   = "/vehicle.v1.VehicleService/FindAllByBrandAndBetweenYears"
+	VehicleService_CalculateAverageSpeedByBrand_FullMethodName    = "/vehicle.v1.VehicleService/CalculateAverageSpeedByBrand"
+	VehicleService_InsertMany_FullMethodName                      = "/vehicle.v1.VehicleService/InsertMany"
+	VehicleService_UpdateMaxSpeedById_FullMethodName              = "/vehicle.v1.VehicleService/UpdateMaxSpeedById"
+	VehicleService_FindAllByFuelType_FullMethodName               = "/vehicle.v1.VehicleService/FindAllByFuelType"
+	VehicleService_Delete_FullMethodName                          = "/vehicle.v1.VehicleService/Delete"
+	VehicleService_FindAllByTransmission_FullMethodName           = "/vehicle.v1.VehicleService/FindAllByTransmission"
+	VehicleService_UpdateFuelTypeById_FullMethodName              = "/vehicle.v1.VehicleService/UpdateFuelTypeById"
+	VehicleService_CalculateAverageCapacityByBrand_FullMethodName = "/vehicle.v1.VehicleService/CalculateAverageCapacityByBrand"
+	VehicleService_FindAllByDimensions_FullMethodName             = "/vehicle.v1.VehicleService/FindAllByDimensions"
+	VehicleService_FindAllByWeight_FullMethodName                 = "/vehicle.v1.VehicleService/FindAllByWeight"
+	VehicleService_FindAllByChargingConnector_FullMethodName      = "/vehicle.v1.VehicleService/FindAllByChargingConnector"
+	VehicleService_FindAllByRange_FullMethodName                  = "/vehicle.v1.VehicleService/FindAllByRange"
+	VehicleService_UpdateOwnerById_FullMethodName                 = "/vehicle.v1.VehicleService/UpdateOwnerById"
+	VehicleService_CalculateStats_FullMethodName                  = "/vehicle.v1.VehicleService/CalculateStats"
+	VehicleService_CalculateHistogram_FullMethodName              = "/vehicle.v1.VehicleService/CalculateHistogram"
+	VehicleService_Search_FullMethodName                          = "/vehicle.v1.VehicleService/Search"
+	VehicleService_FindAllSimilar_FullMethodName                  = "/vehicle.v1.VehicleService/FindAllSimilar"
+)
+
+// VehicleServiceClient is the client API for VehicleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VehicleServiceClient interface {
+	FindAll(ctx context.Context, in *FindAllRequest, opts ...grpc.CallOption) (VehicleService_FindAllClient, error)
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	FindAllByColorAndYear(ctx context.Context, in *FindAllByColorAndYearRequest, opts ...grpc.CallOption) (VehicleService_FindAllByColorAndYearClient, error)
+	FindAllByBrandAndBetweenYears(ctx context.Context, in *FindAllByBrandAndBetweenYearsRequest, opts ...grpc.CallOption) (VehicleService_FindAllByBrandAndBetweenYearsClient, error)
+	CalculateAverageSpeedByBrand(ctx context.Context, in *CalculateAverageByBrandRequest, opts ...grpc.CallOption) (*AverageResponse, error)
+	InsertMany(ctx context.Context, in *InsertManyRequest, opts ...grpc.CallOption) (*InsertManyResponse, error)
+	UpdateMaxSpeedById(ctx context.Context, in *UpdateMaxSpeedByIdRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	FindAllByFuelType(ctx context.Context, in *FindAllByFuelTypeRequest, opts ...grpc.CallOption) (VehicleService_FindAllByFuelTypeClient, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	FindAllByTransmission(ctx context.Context, in *FindAllByTransmissionRequest, opts ...grpc.CallOption) (VehicleService_FindAllByTransmissionClient, error)
+	UpdateFuelTypeById(ctx context.Context, in *UpdateFuelTypeByIdRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	CalculateAverageCapacityByBrand(ctx context.Context, in *CalculateAverageByBrandRequest, opts ...grpc.CallOption) (*AverageResponse, error)
+	FindAllByDimensions(ctx context.Context, in *FindAllByDimensionsRequest, opts ...grpc.CallOption) (VehicleService_FindAllByDimensionsClient, error)
+	FindAllByWeight(ctx context.Context, in *FindAllByWeightRequest, opts ...grpc.CallOption) (VehicleService_FindAllByWeightClient, error)
+	FindAllByChargingConnector(ctx context.Context, in *FindAllByChargingConnectorRequest, opts ...grpc.CallOption) (VehicleService_FindAllByChargingConnectorClient, error)
+	FindAllByRange(ctx context.Context, in *FindAllByRangeRequest, opts ...grpc.CallOption) (VehicleService_FindAllByRangeClient, error)
+	UpdateOwnerById(ctx context.Context, in *UpdateOwnerByIdRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	CalculateStats(ctx context.Context, in *CalculateStatsRequest, opts ...grpc.CallOption) (*CalculateStatsResponse, error)
+	CalculateHistogram(ctx context.Context, in *CalculateHistogramRequest, opts ...grpc.CallOption) (*CalculateHistogramResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (VehicleService_SearchClient, error)
+	FindAllSimilar(ctx context.Context, in *FindAllSimilarRequest, opts ...grpc.CallOption) (VehicleService_FindAllSimilarClient, error)
+}
+
+type vehicleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVehicleServiceClient(cc grpc.ClientConnInterface) VehicleServiceClient {
+	return &vehicleServiceClient{cc}
+}
+
+func (c *vehicleServiceClient) FindAll(ctx context.Context, in *FindAllRequest, opts ...grpc.CallOption) (VehicleService_FindAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[0], VehicleService_FindAll_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_Insert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) FindAllByColorAndYear(ctx context.Context, in *FindAllByColorAndYearRequest, opts ...grpc.CallOption) (VehicleService_FindAllByColorAndYearClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[1], VehicleService_FindAllByColorAndYear_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByColorAndYearClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByColorAndYearClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByColorAndYearClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByColorAndYearClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) FindAllByBrandAndBetweenYears(ctx context.Context, in *FindAllByBrandAndBetweenYearsRequest, opts ...grpc.CallOption) (VehicleService_FindAllByBrandAndBetweenYearsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[2], VehicleService_FindAllByBrandAndBetweenYears_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByBrandAndBetweenYearsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByBrandAndBetweenYearsClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByBrandAndBetweenYearsClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByBrandAndBetweenYearsClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) CalculateAverageSpeedByBrand(ctx context.Context, in *CalculateAverageByBrandRequest, opts ...grpc.CallOption) (*AverageResponse, error) {
+	out := new(AverageResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CalculateAverageSpeedByBrand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) InsertMany(ctx context.Context, in *InsertManyRequest, opts ...grpc.CallOption) (*InsertManyResponse, error) {
+	out := new(InsertManyResponse)
+	err := c.cc.Invoke(ctx, VehicleService_InsertMany_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) UpdateMaxSpeedById(ctx context.Context, in *UpdateMaxSpeedByIdRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateMaxSpeedById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) FindAllByFuelType(ctx context.Context, in *FindAllByFuelTypeRequest, opts ...grpc.CallOption) (VehicleService_FindAllByFuelTypeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[3], VehicleService_FindAllByFuelType_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByFuelTypeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByFuelTypeClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByFuelTypeClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByFuelTypeClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, VehicleService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) FindAllByTransmission(ctx context.Context, in *FindAllByTransmissionRequest, opts ...grpc.CallOption) (VehicleService_FindAllByTransmissionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[4], VehicleService_FindAllByTransmission_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByTransmissionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByTransmissionClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByTransmissionClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByTransmissionClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) UpdateFuelTypeById(ctx context.Context, in *UpdateFuelTypeByIdRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateFuelTypeById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) CalculateAverageCapacityByBrand(ctx context.Context, in *CalculateAverageByBrandRequest, opts ...grpc.CallOption) (*AverageResponse, error) {
+	out := new(AverageResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CalculateAverageCapacityByBrand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) FindAllByDimensions(ctx context.Context, in *FindAllByDimensionsRequest, opts ...grpc.CallOption) (VehicleService_FindAllByDimensionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[5], VehicleService_FindAllByDimensions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByDimensionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByDimensionsClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByDimensionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByDimensionsClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) FindAllByWeight(ctx context.Context, in *FindAllByWeightRequest, opts ...grpc.CallOption) (VehicleService_FindAllByWeightClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[6], VehicleService_FindAllByWeight_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByWeightClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByWeightClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByWeightClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByWeightClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) FindAllByChargingConnector(ctx context.Context, in *FindAllByChargingConnectorRequest, opts ...grpc.CallOption) (VehicleService_FindAllByChargingConnectorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[7], VehicleService_FindAllByChargingConnector_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByChargingConnectorClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByChargingConnectorClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByChargingConnectorClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByChargingConnectorClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) FindAllByRange(ctx context.Context, in *FindAllByRangeRequest, opts ...grpc.CallOption) (VehicleService_FindAllByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[8], VehicleService_FindAllByRange_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllByRangeClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllByRangeClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) UpdateOwnerById(ctx context.Context, in *UpdateOwnerByIdRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateOwnerById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) CalculateStats(ctx context.Context, in *CalculateStatsRequest, opts ...grpc.CallOption) (*CalculateStatsResponse, error) {
+	out := new(CalculateStatsResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CalculateStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) CalculateHistogram(ctx context.Context, in *CalculateHistogramRequest, opts ...grpc.CallOption) (*CalculateHistogramResponse, error) {
+	out := new(CalculateHistogramResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CalculateHistogram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (VehicleService_SearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[9], VehicleService_Search_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_SearchClient interface {
+	Recv() (*SearchResult, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceSearchClient) Recv() (*SearchResult, error) {
+	m := new(SearchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) FindAllSimilar(ctx context.Context, in *FindAllSimilarRequest, opts ...grpc.CallOption) (VehicleService_FindAllSimilarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[10], VehicleService_FindAllSimilar_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceFindAllSimilarClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_FindAllSimilarClient interface {
+	Recv() (*SimilarResult, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceFindAllSimilarClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceFindAllSimilarClient) Recv() (*SimilarResult, error) {
+	m := new(SimilarResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// VehicleServiceServer is the server API for VehicleService service.
+// All implementations must embed UnimplementedVehicleServiceServer
+// for forward compatibility
+type VehicleServiceServer interface {
+	FindAll(*FindAllRequest, VehicleService_FindAllServer) error
+	Insert(context.Context, *InsertRequest) (*Vehicle, error)
+	FindAllByColorAndYear(*FindAllByColorAndYearRequest, VehicleService_FindAllByColorAndYearServer) error
+	FindAllByBrandAndBetweenYears(*FindAllByBrandAndBetweenYearsRequest, VehicleService_FindAllByBrandAndBetweenYearsServer) error
+	CalculateAverageSpeedByBrand(context.Context, *CalculateAverageByBrandRequest) (*AverageResponse, error)
+	InsertMany(context.Context, *InsertManyRequest) (*InsertManyResponse, error)
+	UpdateMaxSpeedById(context.Context, *UpdateMaxSpeedByIdRequest) (*Vehicle, error)
+	FindAllByFuelType(*FindAllByFuelTypeRequest, VehicleService_FindAllByFuelTypeServer) error
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	FindAllByTransmission(*FindAllByTransmissionRequest, VehicleService_FindAllByTransmissionServer) error
+	UpdateFuelTypeById(context.Context, *UpdateFuelTypeByIdRequest) (*Vehicle, error)
+	CalculateAverageCapacityByBrand(context.Context, *CalculateAverageByBrandRequest) (*AverageResponse, error)
+	FindAllByDimensions(*FindAllByDimensionsRequest, VehicleService_FindAllByDimensionsServer) error
+	FindAllByWeight(*FindAllByWeightRequest, VehicleService_FindAllByWeightServer) error
+	FindAllByChargingConnector(*FindAllByChargingConnectorRequest, VehicleService_FindAllByChargingConnectorServer) error
+	FindAllByRange(*FindAllByRangeRequest, VehicleService_FindAllByRangeServer) error
+	UpdateOwnerById(context.Context, *UpdateOwnerByIdRequest) (*Vehicle, error)
+	CalculateStats(context.Context, *CalculateStatsRequest) (*CalculateStatsResponse, error)
+	CalculateHistogram(context.Context, *CalculateHistogramRequest) (*CalculateHistogramResponse, error)
+	Search(*SearchRequest, VehicleService_SearchServer) error
+	FindAllSimilar(*FindAllSimilarRequest, VehicleService_FindAllSimilarServer) error
+	mustEmbedUnimplementedVehicleServiceServer()
+}
+
+// UnimplementedVehicleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVehicleServiceServer struct {
+}
+
+func (UnimplementedVehicleServiceServer) FindAll(*FindAllRequest, VehicleService_FindAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAll not implemented")
+}
+func (UnimplementedVehicleServiceServer) Insert(context.Context, *InsertRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByColorAndYear(*FindAllByColorAndYearRequest, VehicleService_FindAllByColorAndYearServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByColorAndYear not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByBrandAndBetweenYears(*FindAllByBrandAndBetweenYearsRequest, VehicleService_FindAllByBrandAndBetweenYearsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByBrandAndBetweenYears not implemented")
+}
+func (UnimplementedVehicleServiceServer) CalculateAverageSpeedByBrand(context.Context, *CalculateAverageByBrandRequest) (*AverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateAverageSpeedByBrand not implemented")
+}
+func (UnimplementedVehicleServiceServer) InsertMany(context.Context, *InsertManyRequest) (*InsertManyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertMany not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateMaxSpeedById(context.Context, *UpdateMaxSpeedByIdRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxSpeedById not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByFuelType(*FindAllByFuelTypeRequest, VehicleService_FindAllByFuelTypeServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByFuelType not implemented")
+}
+func (UnimplementedVehicleServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByTransmission(*FindAllByTransmissionRequest, VehicleService_FindAllByTransmissionServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByTransmission not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateFuelTypeById(context.Context, *UpdateFuelTypeByIdRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFuelTypeById not implemented")
+}
+func (UnimplementedVehicleServiceServer) CalculateAverageCapacityByBrand(context.Context, *CalculateAverageByBrandRequest) (*AverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateAverageCapacityByBrand not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByDimensions(*FindAllByDimensionsRequest, VehicleService_FindAllByDimensionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByDimensions not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByWeight(*FindAllByWeightRequest, VehicleService_FindAllByWeightServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByWeight not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByChargingConnector(*FindAllByChargingConnectorRequest, VehicleService_FindAllByChargingConnectorServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByChargingConnector not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllByRange(*FindAllByRangeRequest, VehicleService_FindAllByRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllByRange not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateOwnerById(context.Context, *UpdateOwnerByIdRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOwnerById not implemented")
+}
+func (UnimplementedVehicleServiceServer) CalculateStats(context.Context, *CalculateStatsRequest) (*CalculateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateStats not implemented")
+}
+func (UnimplementedVehicleServiceServer) CalculateHistogram(context.Context, *CalculateHistogramRequest) (*CalculateHistogramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateHistogram not implemented")
+}
+func (UnimplementedVehicleServiceServer) Search(*SearchRequest, VehicleService_SearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedVehicleServiceServer) FindAllSimilar(*FindAllSimilarRequest, VehicleService_FindAllSimilarServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindAllSimilar not implemented")
+}
+func (UnimplementedVehicleServiceServer) mustEmbedUnimplementedVehicleServiceServer() {}
+
+// UnsafeVehicleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VehicleServiceServer will
+// result in compilation errors.
+type UnsafeVehicleServiceServer interface {
+	mustEmbedUnimplementedVehicleServiceServer()
+}
+
+func RegisterVehicleServiceServer(s grpc.ServiceRegistrar, srv VehicleServiceServer) {
+	s.RegisterService(&VehicleService_ServiceDesc, srv)
+}
+
+func _VehicleService_FindAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAll(m, &vehicleServiceFindAllServer{stream})
+}
+
+type VehicleService_FindAllServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_Insert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_FindAllByColorAndYear_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByColorAndYearRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByColorAndYear(m, &vehicleServiceFindAllByColorAndYearServer{stream})
+}
+
+type VehicleService_FindAllByColorAndYearServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByColorAndYearServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByColorAndYearServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_FindAllByBrandAndBetweenYears_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByBrandAndBetweenYearsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByBrandAndBetweenYears(m, &vehicleServiceFindAllByBrandAndBetweenYearsServer{stream})
+}
+
+type VehicleService_FindAllByBrandAndBetweenYearsServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByBrandAndBetweenYearsServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByBrandAndBetweenYearsServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_CalculateAverageSpeedByBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateAverageByBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CalculateAverageSpeedByBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CalculateAverageSpeedByBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CalculateAverageSpeedByBrand(ctx, req.(*CalculateAverageByBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_InsertMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).InsertMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_InsertMany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).InsertMany(ctx, req.(*InsertManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_UpdateMaxSpeedById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaxSpeedByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateMaxSpeedById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateMaxSpeedById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateMaxSpeedById(ctx, req.(*UpdateMaxSpeedByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_FindAllByFuelType_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByFuelTypeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByFuelType(m, &vehicleServiceFindAllByFuelTypeServer{stream})
+}
+
+type VehicleService_FindAllByFuelTypeServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByFuelTypeServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByFuelTypeServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_FindAllByTransmission_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByTransmissionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByTransmission(m, &vehicleServiceFindAllByTransmissionServer{stream})
+}
+
+type VehicleService_FindAllByTransmissionServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByTransmissionServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByTransmissionServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_UpdateFuelTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFuelTypeByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateFuelTypeById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateFuelTypeById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateFuelTypeById(ctx, req.(*UpdateFuelTypeByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_CalculateAverageCapacityByBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateAverageByBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CalculateAverageCapacityByBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CalculateAverageCapacityByBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CalculateAverageCapacityByBrand(ctx, req.(*CalculateAverageByBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_FindAllByDimensions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByDimensionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByDimensions(m, &vehicleServiceFindAllByDimensionsServer{stream})
+}
+
+type VehicleService_FindAllByDimensionsServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByDimensionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByDimensionsServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_FindAllByWeight_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByWeightRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByWeight(m, &vehicleServiceFindAllByWeightServer{stream})
+}
+
+type VehicleService_FindAllByWeightServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByWeightServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByWeightServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_FindAllByChargingConnector_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByChargingConnectorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByChargingConnector(m, &vehicleServiceFindAllByChargingConnectorServer{stream})
+}
+
+type VehicleService_FindAllByChargingConnectorServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByChargingConnectorServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByChargingConnectorServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_FindAllByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllByRange(m, &vehicleServiceFindAllByRangeServer{stream})
+}
+
+type VehicleService_FindAllByRangeServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllByRangeServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_UpdateOwnerById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOwnerByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateOwnerById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateOwnerById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateOwnerById(ctx, req.(*UpdateOwnerByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_CalculateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CalculateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CalculateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CalculateStats(ctx, req.(*CalculateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_CalculateHistogram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateHistogramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CalculateHistogram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CalculateHistogram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CalculateHistogram(ctx, req.(*CalculateHistogramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).Search(m, &vehicleServiceSearchServer{stream})
+}
+
+type VehicleService_SearchServer interface {
+	Send(*SearchResult) error
+	grpc.ServerStream
+}
+
+type vehicleServiceSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceSearchServer) Send(m *SearchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_FindAllSimilar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindAllSimilarRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).FindAllSimilar(m, &vehicleServiceFindAllSimilarServer{stream})
+}
+
+type VehicleService_FindAllSimilarServer interface {
+	Send(*SimilarResult) error
+	grpc.ServerStream
+}
+
+type vehicleServiceFindAllSimilarServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceFindAllSimilarServer) Send(m *SimilarResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// VehicleService_ServiceDesc is the grpc.ServiceDesc for VehicleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VehicleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vehicle.v1.VehicleService",
+	HandlerType: (*VehicleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Insert",
+			Handler:    _VehicleService_Insert_Handler,
+		},
+		{
+			MethodName: "CalculateAverageSpeedByBrand",
+			Handler:    _VehicleService_CalculateAverageSpeedByBrand_Handler,
+		},
+		{
+			MethodName: "InsertMany",
+			Handler:    _VehicleService_InsertMany_Handler,
+		},
+		{
+			MethodName: "UpdateMaxSpeedById",
+			Handler:    _VehicleService_UpdateMaxSpeedById_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _VehicleService_Delete_Handler,
+		},
+		{
+			MethodName: "UpdateFuelTypeById",
+			Handler:    _VehicleService_UpdateFuelTypeById_Handler,
+		},
+		{
+			MethodName: "CalculateAverageCapacityByBrand",
+			Handler:    _VehicleService_CalculateAverageCapacityByBrand_Handler,
+		},
+		{
+			MethodName: "UpdateOwnerById",
+			Handler:    _VehicleService_UpdateOwnerById_Handler,
+		},
+		{
+			MethodName: "CalculateStats",
+			Handler:    _VehicleService_CalculateStats_Handler,
+		},
+		{
+			MethodName: "CalculateHistogram",
+			Handler:    _VehicleService_CalculateHistogram_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FindAll",
+			Handler:       _VehicleService_FindAll_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByColorAndYear",
+			Handler:       _VehicleService_FindAllByColorAndYear_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByBrandAndBetweenYears",
+			Handler:       _VehicleService_FindAllByBrandAndBetweenYears_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByFuelType",
+			Handler:       _VehicleService_FindAllByFuelType_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByTransmission",
+			Handler:       _VehicleService_FindAllByTransmission_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByDimensions",
+			Handler:       _VehicleService_FindAllByDimensions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByWeight",
+			Handler:       _VehicleService_FindAllByWeight_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByChargingConnector",
+			Handler:       _VehicleService_FindAllByChargingConnector_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllByRange",
+			Handler:       _VehicleService_FindAllByRange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Search",
+			Handler:       _VehicleService_Search_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindAllSimilar",
+			Handler:       _VehicleService_FindAllSimilar_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "vehicle/v1/vehicle.proto",
+}